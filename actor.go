@@ -0,0 +1,197 @@
+package foursquare
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	ErrActorClosed = errors.New("actor: actor was closed")
+)
+
+// GameActor owns game in its own goroutine, commands from different goroutines are run one by one.
+// Subscribers receive a snapshot of state after each command.
+type GameActor struct {
+	game        *Game
+	commands    chan *actorCommand
+	subscribers map[chan *GameState]struct{}
+	closed      chan struct{}
+	done        chan struct{}
+	closeOnce   sync.Once
+}
+
+type actorCommand struct {
+	fn      func(g *Game) error
+	changes bool
+	result  chan error
+}
+
+func NewGameActor(g *Game) *GameActor {
+
+	a := &GameActor{
+		game:        g,
+		commands:    make(chan *actorCommand),
+		subscribers: make(map[chan *GameState]struct{}),
+		closed:      make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+
+	go a.run()
+
+	return a
+}
+
+func (a *GameActor) run() {
+
+	defer close(a.done)
+
+	for {
+		select {
+		case <-a.closed:
+
+			for ch := range a.subscribers {
+				close(ch)
+			}
+
+			return
+
+		case cmd := <-a.commands:
+
+			// Subscribers have got snapshot before caller knows command has been done
+			err := cmd.fn(a.game)
+			if err == nil && cmd.changes {
+				a.broadcast()
+			}
+
+			cmd.result <- err
+		}
+	}
+}
+
+func (a *GameActor) exec(fn func(g *Game) error, changes bool) error {
+
+	cmd := &actorCommand{
+		fn:      fn,
+		changes: changes,
+		result:  make(chan error, 1),
+	}
+
+	select {
+	case <-a.done:
+		return ErrActorClosed
+	case a.commands <- cmd:
+	}
+
+	return <-cmd.result
+}
+
+// Do runs function in goroutine of actor, function must not call actor, and game must not be used after it returned
+func (a *GameActor) Do(fn func(g *Game) error) error {
+	return a.exec(fn, true)
+}
+
+func (a *GameActor) StartGame() error {
+	return a.Do(func(g *Game) error {
+		return g.StartGame()
+	})
+}
+
+func (a *GameActor) Ready() error {
+	return a.Do(func(g *Game) error {
+		return g.Ready()
+	})
+}
+
+func (a *GameActor) Act(action string) error {
+	return a.Do(func(g *Game) error {
+		return g.Act(action)
+	})
+}
+
+func (a *GameActor) React(playerIdx int, reaction string, selectedTiles []string) error {
+	return a.Do(func(g *Game) error {
+		return g.React(playerIdx, reaction, selectedTiles)
+	})
+}
+
+func (a *GameActor) DiscardTile(tile string) error {
+	return a.Do(func(g *Game) error {
+		return g.DiscardTile(tile)
+	})
+}
+
+func (a *GameActor) ReadyHand(tile string) error {
+	return a.Do(func(g *Game) error {
+		return g.ReadyHand(tile)
+	})
+}
+
+// GetState returns a snapshot of state, it is safe to keep
+func (a *GameActor) GetState() (*GameState, error) {
+
+	var gs *GameState
+	err := a.exec(func(g *Game) error {
+		gs = g.GetState().Clone()
+		return nil
+	}, false)
+
+	return gs, err
+}
+
+// Subscribe returns channel of snapshots, the current one comes first. A slow subscriber skips
+// snapshots in between and always gets the latest. Channel is closed by unsubscribe or Close.
+func (a *GameActor) Subscribe() (<-chan *GameState, func(), error) {
+
+	ch := make(chan *GameState, 1)
+
+	err := a.exec(func(g *Game) error {
+		a.subscribers[ch] = struct{}{}
+		ch <- g.GetState().Clone()
+		return nil
+	}, false)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	unsubscribe := func() {
+		a.exec(func(g *Game) error {
+			if _, ok := a.subscribers[ch]; ok {
+				delete(a.subscribers, ch)
+				close(ch)
+			}
+			return nil
+		}, false)
+	}
+
+	return ch, unsubscribe, nil
+}
+
+func (a *GameActor) broadcast() {
+
+	if len(a.subscribers) == 0 {
+		return
+	}
+
+	gs := a.game.GetState().Clone()
+
+	for ch := range a.subscribers {
+
+		// Replace snapshot which hasn't been received
+		select {
+		case <-ch:
+		default:
+		}
+
+		ch <- gs
+	}
+}
+
+// Close stops actor, commands after it fail with ErrActorClosed
+func (a *GameActor) Close() {
+	a.closeOnce.Do(func() {
+		close(a.closed)
+	})
+
+	<-a.done
+}
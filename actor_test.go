@@ -0,0 +1,119 @@
+package foursquare
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestActor(t *testing.T, table *Table) *GameActor {
+
+	a := NewGameActor(table.GetGame())
+	t.Cleanup(a.Close)
+
+	return a
+}
+
+func Test_GameActor_ConcurrentReactions(t *testing.T) {
+
+	table := newTestTable(nil, 1)
+
+	// Play until somebody is able to react
+	assert.Nil(t, table.GetGame().StartGame())
+	stepUntil(t, table, GameEvent_WaitForReaction)
+
+	a := newTestActor(t, table)
+
+	// Everybody passes at the same time, only the first one counts
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- a.React(-1, "", []string{})
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	succeeded := 0
+	for err := range errs {
+		if err == nil {
+			succeeded++
+			continue
+		}
+
+		assert.ErrorIs(t, err, ErrInvalidGameStatus)
+		assert.Equal(t, ErrorCode_InvalidGameStatus, GetErrorCode(err))
+	}
+
+	assert.Equal(t, 1, succeeded)
+}
+
+func Test_GameActor_Subscribe(t *testing.T) {
+
+	table := newTestTable(nil, 1)
+	a := newTestActor(t, table)
+
+	ch, unsubscribe, err := a.Subscribe()
+	assert.Nil(t, err)
+
+	// Current state
+	gs := <-ch
+	assert.Equal(t, "", gs.Status.CurrentEvent)
+
+	assert.Nil(t, a.StartGame())
+
+	gs = <-ch
+	assert.Equal(t, GetGameEventSymbols(GameEvent_WaitForReady), gs.Status.CurrentEvent)
+
+	// Snapshot is not affected by game
+	assert.Nil(t, a.Do(func(g *Game) error {
+		return table.Step()
+	}))
+	assert.Equal(t, GetGameEventSymbols(GameEvent_WaitForReady), gs.Status.CurrentEvent)
+
+	// Slow subscriber gets the latest one
+	assert.Nil(t, a.Do(func(g *Game) error {
+		return table.Step()
+	}))
+
+	gs = <-ch
+	latest, _ := a.GetState()
+	assert.Equal(t, latest, gs)
+
+	// Invalid command changes nothing
+	assert.NotNil(t, a.DiscardTile("X1"))
+	assert.Equal(t, 0, len(ch))
+
+	unsubscribe()
+	_, ok := <-ch
+	assert.False(t, ok)
+	unsubscribe()
+}
+
+func Test_GameActor_Close(t *testing.T) {
+
+	a := newTestActor(t, newTestTable(nil, 1))
+
+	ch, _, err := a.Subscribe()
+	assert.Nil(t, err)
+	<-ch
+
+	a.Close()
+
+	_, ok := <-ch
+	assert.False(t, ok)
+
+	assert.Equal(t, ErrActorClosed, a.StartGame())
+
+	_, err = a.GetState()
+	assert.Equal(t, ErrActorClosed, err)
+
+	_, _, err = a.Subscribe()
+	assert.Equal(t, ErrActorClosed, err)
+}
@@ -0,0 +1,461 @@
+package foursquare
+
+import (
+	"sort"
+	"strconv"
+)
+
+// Bot is a built-in agent which plays with simple heuristics based on shanten number (向聽數)
+type Bot struct{}
+
+func NewBot() *Bot {
+	return &Bot{}
+}
+
+func (b *Bot) DecideAction(gs *GameState, ps *PlayerState) (*Decision, error) {
+
+	if ps.IsAllowedAction("win") {
+		return &Decision{Action: "win"}, nil
+	}
+
+	// Keep waiting tiles of ready hand
+	if ps.IsReadyHand || !ps.IsAllowedAction("kong") {
+		return &Decision{Action: "discard"}, nil
+	}
+
+	tile := ps.Hand.Draw[0]
+
+	// Adding tile to melded pung never breaks hand
+	if ContainsTile(ps.Hand.Triplet, tile) {
+		return &Decision{Action: "kong"}, nil
+	}
+
+	def := gs.Meta.TileSetDef
+
+	rest, _ := RemoveTiles(ps.Hand.Tiles, []string{tile, tile, tile, tile})
+	if FigureShantenWithTileSet(def, rest) <= b.figureShantenAfterDiscard(def, ps.Hand.Tiles) {
+		return &Decision{Action: "kong"}, nil
+	}
+
+	return &Decision{Action: "discard"}, nil
+}
+
+func (b *Bot) DecideDiscard(gs *GameState, ps *PlayerState) (*Decision, error) {
+
+	// Declare ready hand and wait for tiles which are still alive
+	for _, a := range ps.AllowedActions {
+
+		if a.Name != "readyhand" || len(a.ReadyHandCandidates) == 0 {
+			continue
+		}
+
+		c := b.figureReadyHandCandidate(gs, ps, a.ReadyHandCandidates)
+
+		return &Decision{Action: "readyhand", Tile: c.DiscardedTile}, nil
+	}
+
+	return &Decision{Action: "discard", Tile: b.figureDiscard(gs.Meta.TileSetDef, ps.Hand.Tiles)}, nil
+}
+
+func (b *Bot) DecideReaction(gs *GameState, ps *PlayerState) (*Decision, error) {
+
+	if ps.IsAllowedAction("win") {
+		return &Decision{Action: "win"}, nil
+	}
+
+	if ps.IsReadyHand || len(gs.Status.DiscardArea) == 0 {
+		return &Decision{}, nil
+	}
+
+	def := gs.Meta.TileSetDef
+	tile := gs.Status.DiscardArea[len(gs.Status.DiscardArea)-1]
+	current := FigureShantenWithTileSet(def, ps.Hand.Tiles)
+
+	if ps.IsAllowedAction("kong") {
+		rest, _ := RemoveTiles(ps.Hand.Tiles, []string{tile, tile, tile})
+		if FigureShantenWithTileSet(def, rest) <= current {
+			return &Decision{Action: "kong"}, nil
+		}
+	}
+
+	if ps.IsAllowedAction("pung") {
+		rest, _ := RemoveTiles(ps.Hand.Tiles, []string{tile, tile})
+		if b.figureShantenAfterDiscard(def, rest) < current {
+			return &Decision{Action: "pung"}, nil
+		}
+	}
+
+	for _, a := range ps.AllowedActions {
+
+		if a.Name != "chow" {
+			continue
+		}
+
+		for _, c := range a.Candidates {
+			rest, _ := RemoveTiles(ps.Hand.Tiles, c)
+			if b.figureShantenAfterDiscard(def, rest) < current {
+				return &Decision{Action: "chow", Tiles: c}, nil
+			}
+		}
+	}
+
+	return &Decision{}, nil
+}
+
+func (b *Bot) figureShantenAfterDiscard(def *TileSetDef, tiles []string) int {
+
+	best := -1
+	for _, t := range getDistinctTiles(tiles) {
+
+		rest, _ := RemoveTiles(tiles, []string{t})
+
+		s := FigureShantenWithTileSet(def, rest)
+		if best == -1 || s < best {
+			best = s
+		}
+	}
+
+	return best
+}
+
+// figureDiscard returns tile which keeps the lowest shanten number, isolated tiles go first
+func (b *Bot) figureDiscard(def *TileSetDef, tiles []string) string {
+
+	layout := newShantenLayout(def)
+
+	var tile string
+	bestShanten := 0
+	bestConnectivity := 0
+
+	for _, t := range getDistinctTiles(tiles) {
+
+		rest, _ := RemoveTiles(tiles, []string{t})
+
+		s := layout.figureShanten(rest)
+		c := layout.figureConnectivity(tiles, t)
+
+		if tile == "" || s < bestShanten || (s == bestShanten && c < bestConnectivity) {
+			tile = t
+			bestShanten = s
+			bestConnectivity = c
+		}
+	}
+
+	return tile
+}
+
+// figureReadyHandCandidate returns candidate which waits for the most tiles that have not shown up
+func (b *Bot) figureReadyHandCandidate(gs *GameState, ps *PlayerState, candidates []*DiscardCandidate) *DiscardCandidate {
+
+	visible := make([]string, 0)
+	visible = append(visible, gs.Status.DiscardArea...)
+	visible = append(visible, ps.Hand.Tiles...)
+
+	for _, p := range gs.Players {
+		if p.Idx == ps.Idx || p.Hand == nil {
+			continue
+		}
+
+		melded := p.Hand.Clone()
+		melded.Tiles = []string{}
+		melded.Flowers = []string{}
+		melded.Kong.Concealed = []string{}
+		visible = append(visible, melded.GetAllTiles()...)
+	}
+
+	var best *DiscardCandidate
+	bestCount := -1
+
+	for _, c := range candidates {
+
+		count := 0
+		for _, t := range c.TargetTiles {
+			count += getTileCopies(gs.Meta.TileSetDef, t) - CountSpecificTile(visible, t)
+		}
+
+		if count > bestCount {
+			best = c
+			bestCount = count
+		}
+	}
+
+	return best
+}
+
+// getTileCopies returns copies of tile in tile set
+func getTileCopies(def *TileSetDef, tile string) int {
+
+	if def == nil {
+		def = StandardSetOfTiles
+	}
+
+	if len(tile) == 0 {
+		return 0
+	}
+
+	td := def.GetSuit(TileSuit(tile[0:1]))
+	if td == nil {
+		return 0
+	}
+
+	return td.Count
+}
+
+// getDistinctTiles returns sorted tiles without duplicates, so that bot decides in the same way every time
+func getDistinctTiles(tiles []string) []string {
+	distinct := AggregateTiles(tiles)
+	sort.Strings(distinct)
+	return distinct
+}
+
+// FigureShanten returns number of tiles a hand needs to be ready, 0 means ready hand and -1 means winning hand.
+func FigureShanten(tiles []string) int {
+	return FigureShantenWithTileSet(StandardSetOfTiles, tiles)
+}
+
+// FigureShantenWithTileSet returns shanten number of tiles, suits are played by their kinds in tile set
+func FigureShantenWithTileSet(def *TileSetDef, tiles []string) int {
+	return newShantenLayout(def).figureShanten(tiles)
+}
+
+func (l *shantenLayout) figureShanten(tiles []string) int {
+
+	counts, wilds := l.makeShantenCounts(tiles)
+
+	sets := len(tiles) / 3
+	best := l.searchShanten(&counts, 0, sets, 0, 0, false)
+
+	// Take eyes first
+	for i := range counts {
+
+		if counts[i] < 2 {
+			continue
+		}
+
+		counts[i] -= 2
+		if s := l.searchShanten(&counts, 0, sets, 0, 0, true); s < best {
+			best = s
+		}
+		counts[i] += 2
+	}
+
+	// Every wild tile takes place of a tile which is needed
+	best -= wilds
+	if best < -1 {
+		best = -1
+	}
+
+	return best
+}
+
+// Index of tile is slot*10+number, slots of numbered suits come first
+type shantenCounts [100]int
+
+// shantenLayout tells slots of suits, tiles of suits which don't make sets have no slot
+type shantenLayout struct {
+	def    *TileSetDef
+	slots  map[TileSuit]int
+	suited int // Tiles before this index make straights
+}
+
+func newShantenLayout(def *TileSetDef) *shantenLayout {
+
+	if def == nil {
+		def = StandardSetOfTiles
+	}
+
+	l := &shantenLayout{
+		def:   def,
+		slots: make(map[TileSuit]int),
+	}
+
+	for _, rules := range []*ResolverRules{SuitedTileRule, HonorTileRule} {
+		for _, td := range def.Suits {
+
+			if _, ok := l.slots[td.Suit]; ok || GetResolverRules(def, td.Suit) != rules {
+				continue
+			}
+
+			// Bonus tiles never stay in hand
+			if def.GetSuitKind(td.Suit) == TileKindBonus {
+				continue
+			}
+
+			// No room for more suits
+			if len(l.slots)*10 >= len(shantenCounts{}) {
+				break
+			}
+
+			l.slots[td.Suit] = len(l.slots)
+		}
+
+		if rules == SuitedTileRule {
+			l.suited = len(l.slots) * 10
+		}
+	}
+
+	return l
+}
+
+// makeShantenCounts returns counts of tiles and number of wild tiles, tiles which have no slot never make sets
+func (l *shantenLayout) makeShantenCounts(tiles []string) (shantenCounts, int) {
+
+	var counts shantenCounts
+	wilds := 0
+
+	for _, t := range tiles {
+
+		if l.def.IsWildTile(t) {
+			wilds++
+			continue
+		}
+
+		idx := l.getShantenIndex(t)
+		if idx == -1 {
+			continue
+		}
+
+		counts[idx]++
+	}
+
+	return counts, wilds
+}
+
+func (l *shantenLayout) getShantenIndex(tile string) int {
+
+	if len(tile) < 2 {
+		return -1
+	}
+
+	num, err := strconv.Atoi(tile[1:])
+	if err != nil || num < 1 || num > 9 {
+		return -1
+	}
+
+	slot, ok := l.slots[TileSuit(tile[0:1])]
+	if !ok {
+		return -1
+	}
+
+	return slot*10 + num
+}
+
+func (l *shantenLayout) isShantenSuited(idx int) bool {
+	return idx < l.suited
+}
+
+func (l *shantenLayout) searchShanten(counts *shantenCounts, start int, sets int, melds int, partials int, hasEyes bool) int {
+
+	i := start
+	for i < len(counts) && counts[i] == 0 {
+		i++
+	}
+
+	// No more tiles
+	if i >= len(counts) {
+
+		if melds+partials > sets {
+			partials = sets - melds
+		}
+
+		s := 2*(sets-melds) - partials
+		if hasEyes {
+			s--
+		}
+
+		return s
+	}
+
+	best := 2*sets + 1
+	try := func(s int) {
+		if s < best {
+			best = s
+		}
+	}
+
+	// Triplet
+	if counts[i] >= 3 {
+		counts[i] -= 3
+		try(l.searchShanten(counts, i, sets, melds+1, partials, hasEyes))
+		counts[i] += 3
+	}
+
+	suited := l.isShantenSuited(i)
+
+	// Straight
+	if suited && i%10 <= 7 && counts[i+1] > 0 && counts[i+2] > 0 {
+		counts[i]--
+		counts[i+1]--
+		counts[i+2]--
+		try(l.searchShanten(counts, i, sets, melds+1, partials, hasEyes))
+		counts[i]++
+		counts[i+1]++
+		counts[i+2]++
+	}
+
+	// Partial sets are useless if there are enough sets
+	if melds+partials < sets {
+
+		// Pair
+		if counts[i] >= 2 {
+			counts[i] -= 2
+			try(l.searchShanten(counts, i, sets, melds, partials+1, hasEyes))
+			counts[i] += 2
+		}
+
+		// Two tiles next to each other
+		if suited && i%10 <= 8 && counts[i+1] > 0 {
+			counts[i]--
+			counts[i+1]--
+			try(l.searchShanten(counts, i, sets, melds, partials+1, hasEyes))
+			counts[i]++
+			counts[i+1]++
+		}
+
+		// Two tiles with a gap
+		if suited && i%10 <= 7 && counts[i+2] > 0 {
+			counts[i]--
+			counts[i+2]--
+			try(l.searchShanten(counts, i, sets, melds, partials+1, hasEyes))
+			counts[i]++
+			counts[i+2]++
+		}
+	}
+
+	// Isolated tile
+	counts[i]--
+	try(l.searchShanten(counts, i, sets, melds, partials, hasEyes))
+	counts[i]++
+
+	return best
+}
+
+// figureConnectivity returns how many tiles are close to the tile
+func (l *shantenLayout) figureConnectivity(tiles []string, tile string) int {
+
+	idx := l.getShantenIndex(tile)
+	if idx == -1 {
+		return 0
+	}
+
+	counts, _ := l.makeShantenCounts(tiles)
+
+	// Tile itself
+	c := counts[idx] - 1
+
+	if !l.isShantenSuited(idx) {
+		return c * 2
+	}
+
+	for d := -2; d <= 2; d++ {
+
+		n := idx + d
+		if d == 0 || n < 0 || n%10 == 0 || n/10 != idx/10 {
+			continue
+		}
+
+		c += counts[n]
+	}
+
+	return c
+}
@@ -0,0 +1,204 @@
+package foursquare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Bot_FigureShanten(t *testing.T) {
+
+	cases := []struct {
+		Shanten int
+		Tiles   []string
+	}{
+		{-1, []string{"W1", "W2", "W3", "T5", "T5"}},
+		{0, []string{"W1", "W2", "W3", "T5"}},
+		{0, []string{"W1", "W2", "T5", "T5"}},
+		{1, []string{"W1", "W2", "T5", "B9"}},
+		{0, []string{"I1", "I1", "I1", "D1"}},
+		{2, []string{"I1", "I2", "I3", "D1"}},
+		{-1, []string{"W1", "W2", "W3", "W4", "W5", "W6", "W7", "W8", "W9", "T1", "T2", "T3", "I1", "I1", "I1", "D2", "D2"}},
+	}
+
+	for i, c := range cases {
+		assert.Equal(t, c.Shanten, FigureShanten(c.Tiles), i)
+	}
+}
+
+func Test_Bot_DecideDiscard(t *testing.T) {
+
+	b := NewBot()
+
+	ps := &PlayerState{
+		Hand: NewHand(),
+	}
+
+	// Isolated honor tile goes first
+	ps.Hand.Tiles = []string{"W1", "W2", "W3", "T4", "T5", "B7", "B7", "D1"}
+	ps.AllowActions([]*Action{{Name: "discard"}})
+
+	d, err := b.DecideDiscard(&GameState{}, ps)
+	assert.Nil(t, err)
+	assert.Equal(t, "discard", d.Action)
+	assert.Equal(t, "D1", d.Tile)
+
+	// Declare ready hand with more tiles alive
+	ps.ResetAllowedActions()
+	ps.AllowActions([]*Action{
+		{Name: "discard"},
+		{
+			Name: "readyhand",
+			ReadyHandCandidates: []*DiscardCandidate{
+				{DiscardedTile: "T4", TargetTiles: []string{"D1"}},
+				{DiscardedTile: "D1", TargetTiles: []string{"T3", "T6"}},
+			},
+		},
+	})
+
+	d, err = b.DecideDiscard(&GameState{}, ps)
+	assert.Nil(t, err)
+	assert.Equal(t, "readyhand", d.Action)
+	assert.Equal(t, "D1", d.Tile)
+}
+
+func Test_Bot_DecideReaction(t *testing.T) {
+
+	b := NewBot()
+
+	gs := &GameState{}
+	gs.Status.DiscardArea = []string{"B7"}
+
+	ps := &PlayerState{
+		Hand: NewHand(),
+	}
+
+	// Pung makes hand ready
+	ps.Hand.Tiles = []string{"W1", "W2", "W3", "T4", "T5", "B7", "B7", "D1", "D1", "I3"}
+	ps.AllowActions([]*Action{{Name: "pung"}})
+
+	d, err := b.DecideReaction(gs, ps)
+	assert.Nil(t, err)
+	assert.Equal(t, "pung", d.Action)
+
+	// Chow breaks a set
+	gs.Status.DiscardArea = []string{"W4"}
+	ps.ResetAllowedActions()
+	ps.AllowActions([]*Action{{Name: "chow", Candidates: [][]string{{"W2", "W3"}}}})
+
+	d, err = b.DecideReaction(gs, ps)
+	assert.Nil(t, err)
+	assert.Equal(t, "", d.Action)
+
+	// Always win
+	ps.ResetAllowedActions()
+	ps.AllowActions([]*Action{{Name: "win"}})
+
+	d, err = b.DecideReaction(gs, ps)
+	assert.Nil(t, err)
+	assert.Equal(t, "win", d.Action)
+}
+
+func Test_Bot_Table(t *testing.T) {
+
+	wins := 0
+
+	for i := 0; i < 5; i++ {
+
+		opts := NewOptions()
+		opts.Dices = RollDices()
+		opts.Tiles = ShuffleTiles(NewTileSet(opts.TileSetDef))
+
+		table := NewTable(NewGame(opts))
+		for j := 0; j < opts.PlayerCount; j++ {
+			table.Sit(j, NewBot())
+		}
+
+		result, err := table.Run()
+		assert.Nil(t, err)
+		assert.NotNil(t, result)
+
+		if !result.IsDrawnGame {
+			wins++
+		}
+	}
+
+	t.Logf("%d of 5 games were won", wins)
+}
+
+func Test_Bot_FigureShanten_CustomSuits(t *testing.T) {
+
+	def := &TileSetDef{
+		Suits: append(append([]TileDef{}, StandardSetOfTiles.Suits...), TileDef{"X", TileKindNumbered, 9, 4, nil}),
+	}
+
+	assert.Equal(t, -1, FigureShantenWithTileSet(def, []string{"X1", "X2", "X3", "T5", "T5"}))
+	assert.Equal(t, 0, FigureShantenWithTileSet(def, []string{"X1", "X3", "T5", "T5"}))
+
+	// Suit is played as honor tiles
+	def.Suits[len(def.Suits)-1].Kind = TileKindHonor
+	assert.Equal(t, 1, FigureShantenWithTileSet(def, []string{"X1", "X3", "T5", "T5"}))
+
+	// Standard tile set doesn't have suit X, tiles of it never make sets
+	assert.Equal(t, 1, FigureShanten([]string{"X1", "X3", "T5", "T5"}))
+}
+
+func Test_Bot_FigureShanten_Wilds(t *testing.T) {
+
+	def := newJokerSetOfTiles(WildDef{InEyes: true, InChow: true})
+
+	assert.Equal(t, -1, FigureShantenWithTileSet(def, []string{"W1", "W2", "J1", "T5", "T5"}))
+	assert.Equal(t, 0, FigureShantenWithTileSet(def, []string{"W1", "J1", "T5", "T5"}))
+	assert.Equal(t, 1, FigureShantenWithTileSet(def, []string{"W1", "W5", "B9", "J1"}))
+
+	// Joker is kept while an isolated tile goes first
+	b := NewBot()
+	gs := &GameState{}
+	gs.Meta.TileSetDef = def
+
+	ps := &PlayerState{
+		Hand: NewHand(),
+	}
+	ps.Hand.Tiles = []string{"J1", "W1", "W2", "T4", "T5", "B7", "B7", "D1"}
+	ps.AllowActions([]*Action{{Name: "discard"}})
+
+	d, err := b.DecideDiscard(gs, ps)
+	assert.Nil(t, err)
+	assert.Equal(t, "D1", d.Tile)
+}
+
+func Test_Bot_DecideDiscard_TileCopies(t *testing.T) {
+
+	b := NewBot()
+
+	// Only one copy of each wan tile
+	def := &TileSetDef{
+		Suits: []TileDef{
+			{TileSuitWan, TileKindNumbered, 9, 1, nil},
+			{TileSuitDragon, TileKindHonor, 3, 4, nil},
+		},
+	}
+
+	gs := &GameState{}
+	gs.Meta.TileSetDef = def
+
+	ps := &PlayerState{
+		Hand: NewHand(),
+	}
+	ps.Hand.Tiles = []string{"W1", "W2", "W4", "W5", "D1", "D2"}
+	ps.AllowActions([]*Action{
+		{Name: "discard"},
+		{
+			Name: "readyhand",
+			ReadyHandCandidates: []*DiscardCandidate{
+				{DiscardedTile: "D1", TargetTiles: []string{"W3", "W6"}},
+				{DiscardedTile: "W1", TargetTiles: []string{"D3"}},
+			},
+		},
+	})
+
+	d, err := b.DecideDiscard(gs, ps)
+	assert.Nil(t, err)
+	assert.Equal(t, "readyhand", d.Action)
+	assert.Equal(t, "W1", d.Tile)
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/weedbox/foursquare"
+)
+
+// humanAgent asks player in terminal for decisions
+type humanAgent struct {
+	in   *bufio.Reader
+	out  io.Writer
+	opts *foursquare.RenderOptions
+}
+
+func newHumanAgent(in io.Reader, out io.Writer, opts *foursquare.RenderOptions) *humanAgent {
+	return &humanAgent{
+		in:   bufio.NewReader(in),
+		out:  out,
+		opts: opts,
+	}
+}
+
+func (h *humanAgent) show(gs *foursquare.GameState) {
+	fmt.Fprintln(h.out)
+	fmt.Fprint(h.out, foursquare.RenderState(gs, h.opts))
+}
+
+func (h *humanAgent) ask(prompt string) (string, error) {
+
+	fmt.Fprintf(h.out, "%s> ", prompt)
+
+	line, err := h.in.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+
+	return strings.TrimSpace(line), nil
+}
+
+func (h *humanAgent) DecideAction(gs *foursquare.GameState, ps *foursquare.PlayerState) (*foursquare.Decision, error) {
+
+	h.show(gs)
+
+	for {
+		input, err := h.ask("win / kong / enter to discard")
+		if err != nil {
+			return nil, err
+		}
+
+		switch input {
+		case "":
+			return &foursquare.Decision{Action: "discard"}, nil
+		case "win", "kong":
+			if ps.IsAllowedAction(input) {
+				return &foursquare.Decision{Action: input}, nil
+			}
+		}
+
+		fmt.Fprintln(h.out, "Not allowed")
+	}
+}
+
+func (h *humanAgent) DecideDiscard(gs *foursquare.GameState, ps *foursquare.PlayerState) (*foursquare.Decision, error) {
+
+	h.show(gs)
+
+	for {
+		input, err := h.ask("tile to discard, or ready <tile>, enter to discard the drawn tile")
+		if err != nil {
+			return nil, err
+		}
+
+		if input == "" && len(ps.Hand.Draw) > 0 {
+			input = ps.Hand.Draw[0]
+		}
+
+		action := "discard"
+
+		fields := strings.Fields(input)
+		if len(fields) == 2 && fields[0] == "ready" {
+			action = "readyhand"
+			input = fields[1]
+		}
+
+		if action == "readyhand" && !ps.IsAllowedAction("readyhand") {
+			fmt.Fprintln(h.out, "Not ready yet")
+			continue
+		}
+
+		tile, ok := parseTileInput(input)
+		if !ok || !foursquare.ContainsTile(ps.Hand.Tiles, tile) {
+			fmt.Fprintln(h.out, "No such tile")
+			continue
+		}
+
+		return &foursquare.Decision{Action: action, Tile: tile}, nil
+	}
+}
+
+func (h *humanAgent) DecideReaction(gs *foursquare.GameState, ps *foursquare.PlayerState) (*foursquare.Decision, error) {
+
+	h.show(gs)
+
+	for {
+		input, err := h.ask("win / kong / pung / chow [n] / enter to pass")
+		if err != nil {
+			return nil, err
+		}
+
+		fields := strings.Fields(input)
+		if len(fields) == 0 {
+			return &foursquare.Decision{}, nil
+		}
+
+		if !ps.IsAllowedAction(fields[0]) {
+			fmt.Fprintln(h.out, "Not allowed")
+			continue
+		}
+
+		if fields[0] != "chow" {
+			return &foursquare.Decision{Action: fields[0]}, nil
+		}
+
+		// Select one of candidates for chow, the first one by default
+		idx := 1
+		if len(fields) > 1 {
+			idx, err = strconv.Atoi(fields[1])
+			if err != nil {
+				fmt.Fprintln(h.out, "Invalid candidate")
+				continue
+			}
+		}
+
+		candidates := getChowCandidates(ps)
+		if idx < 1 || idx > len(candidates) {
+			fmt.Fprintln(h.out, "Invalid candidate")
+			continue
+		}
+
+		return &foursquare.Decision{Action: "chow", Tiles: candidates[idx-1]}, nil
+	}
+}
+
+func getChowCandidates(ps *foursquare.PlayerState) [][]string {
+
+	for _, a := range ps.AllowedActions {
+		if a.Name == "chow" {
+			return a.Candidates
+		}
+	}
+
+	return [][]string{}
+}
+
+// parseTileInput accepts tile code like W3 or notation like 3w
+func parseTileInput(input string) (string, bool) {
+
+	code := strings.ToUpper(input)
+	if len(code) == 2 && code[1] >= '1' && code[1] <= '9' {
+		return code, true
+	}
+
+	tiles, err := foursquare.ParseTiles(strings.ToLower(input))
+	if err != nil || len(tiles) != 1 {
+		return "", false
+	}
+
+	return tiles[0], true
+}
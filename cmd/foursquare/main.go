@@ -0,0 +1,109 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/weedbox/foursquare"
+)
+
+var tileNames = map[string]func(string) string{
+	"glyph":   foursquare.TileGlyph,
+	"chinese": foursquare.TileChineseName,
+	"english": foursquare.TileEnglishName,
+	"code":    func(tile string) string { return tile },
+}
+
+func main() {
+
+	seed := flag.Int64("seed", 0, "seed of dices and tiles, random if it is zero")
+	seat := flag.Int("seat", 0, "seat of human player")
+	rounds := flag.Int("rounds", 0, "number of rounds for a session, a single hand if it is zero")
+	names := flag.String("names", "chinese", "tile names: glyph, chinese, english or code")
+	flag.Parse()
+
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+
+	nameFn, ok := tileNames[*names]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown tile names: %s\n", *names)
+		os.Exit(2)
+	}
+
+	fmt.Printf("Seed: %d\n", *seed)
+
+	ro := foursquare.NewRenderOptions()
+	ro.ViewerIdx = *seat
+	ro.TileName = nameFn
+
+	human := newHumanAgent(os.Stdin, os.Stdout, ro)
+
+	err := play(rand.New(rand.NewSource(*seed)), *seat, *rounds, human, ro)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func play(r *rand.Rand, seat int, rounds int, human foursquare.Agent, ro *foursquare.RenderOptions) error {
+
+	sopts := foursquare.NewSessionOptions()
+	if rounds > 0 {
+		sopts.Rounds = rounds
+	}
+
+	s := foursquare.NewSession(sopts)
+
+	for !s.GetState().IsFinished {
+
+		opts, err := s.NextOptions()
+		if err != nil {
+			return err
+		}
+
+		opts.Dices = foursquare.RollDicesWithRand(r)
+		opts.Tiles = foursquare.ShuffleTilesWithRand(foursquare.NewTileSet(opts.TileSetDef), r)
+
+		table := foursquare.NewTable(foursquare.NewGame(opts))
+		for i := 0; i < opts.PlayerCount; i++ {
+
+			if i == seat {
+				table.Sit(i, human)
+				continue
+			}
+
+			table.Sit(i, foursquare.NewBot())
+		}
+
+		result, err := table.Run()
+		if err != nil {
+			return err
+		}
+
+		// Reveal everything at the end of hand
+		fmt.Println()
+		fmt.Print(foursquare.RenderState(table.GetGame().GetState(), &foursquare.RenderOptions{
+			ViewerIdx: -1,
+			TileName:  ro.TileName,
+		}))
+
+		// Single hand
+		if rounds == 0 {
+			return nil
+		}
+
+		err = s.ApplyResult(result)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Scores after %d hands: %v\n", s.GetState().HandCount, s.GetState().Scores)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package foursquare
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrorCode is a machine-readable reason why game rejected a command
+type ErrorCode string
+
+const (
+	ErrorCode_InvalidAction     ErrorCode = "invalid_action"
+	ErrorCode_InvalidReaction   ErrorCode = "invalid_reaction"
+	ErrorCode_NoSuchTile        ErrorCode = "no_such_tile"
+	ErrorCode_InvalidPlayer     ErrorCode = "invalid_player"
+	ErrorCode_InvalidGameStatus ErrorCode = "invalid_game_status"
+)
+
+var errorCodes = []struct {
+	err  error
+	code ErrorCode
+}{
+	{ErrInvalidAction, ErrorCode_InvalidAction},
+	{ErrInvalidReaction, ErrorCode_InvalidReaction},
+	{ErrPlayerHasNoSuchTile, ErrorCode_NoSuchTile},
+	{ErrInvalidPlayer, ErrorCode_InvalidPlayer},
+	{ErrInvalidGameStatus, ErrorCode_InvalidGameStatus},
+}
+
+// CommandError tells which command was rejected and what game looked like, errors.Is works with the wrapped error
+type CommandError struct {
+	Err            error     `json:"-"`
+	Code           ErrorCode `json:"code"`
+	Command        string    `json:"command"` // act, react, discard, readyhand or ready
+	Player         int       `json:"player"`
+	Action         string    `json:"action,omitempty"`
+	Tile           string    `json:"tile,omitempty"`
+	Tiles          []string  `json:"tiles,omitempty"`
+	Event          string    `json:"event"`           // Current event when command was rejected
+	AllowedActions []string  `json:"allowed_actions"` // Actions which player was allowed to do
+}
+
+func (e *CommandError) Error() string {
+
+	details := []string{
+		fmt.Sprintf("player %d", e.Player),
+	}
+
+	if e.Action != "" {
+		details = append(details, "action "+e.Action)
+	}
+
+	if e.Tile != "" {
+		details = append(details, "tile "+e.Tile)
+	}
+
+	if len(e.Tiles) > 0 {
+		details = append(details, "tiles "+strings.Join(e.Tiles, ","))
+	}
+
+	details = append(details,
+		"event "+e.Event,
+		"allowed ["+strings.Join(e.AllowedActions, ",")+"]",
+	)
+
+	return fmt.Sprintf("%s (%s: %s)", e.Err.Error(), e.Command, strings.Join(details, ", "))
+}
+
+func (e *CommandError) Unwrap() error {
+	return e.Err
+}
+
+// GetErrorCode returns code of error which game returned, it is empty if error is not about command
+func GetErrorCode(err error) ErrorCode {
+
+	var ce *CommandError
+	if errors.As(err, &ce) {
+		return ce.Code
+	}
+
+	for _, c := range errorCodes {
+		if errors.Is(err, c.err) {
+			return c.code
+		}
+	}
+
+	return ""
+}
+
+// newCommandError adds context to error, errors which are not about commands are returned as they are
+func (g *Game) newCommandError(err error, a RecordAction) error {
+
+	var ce *CommandError
+	if errors.As(err, &ce) {
+		return err
+	}
+
+	code := GetErrorCode(err)
+	if code == "" {
+		return err
+	}
+
+	ce = &CommandError{
+		Err:            err,
+		Code:           code,
+		Command:        a.Type,
+		Player:         a.Player,
+		Action:         a.Action,
+		Tile:           a.Tile,
+		Tiles:          a.Tiles,
+		Event:          g.gs.Status.CurrentEvent,
+		AllowedActions: make([]string, 0),
+	}
+
+	if ps := g.GetPlayer(a.Player); ps != nil {
+		for _, action := range ps.AllowedActions {
+			ce.AllowedActions = append(ce.AllowedActions, action.Name)
+		}
+	}
+
+	return ce
+}
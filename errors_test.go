@@ -0,0 +1,70 @@
+package foursquare
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestGameForDiscard(t *testing.T) *Game {
+
+	table := newTestTable(nil, 1)
+
+	// Play until somebody has to discard a tile
+	assert.Nil(t, table.GetGame().StartGame())
+	stepUntil(t, table, GameEvent_WaitForPlayerToDiscardTile)
+
+	return table.GetGame()
+}
+
+func Test_CommandError_NoSuchTile(t *testing.T) {
+
+	g := newTestGameForDiscard(t)
+	ps := g.GetCurrentPlayer()
+
+	// Flowers never stay in hand
+	tile := "F1"
+
+	err := g.DiscardTile(tile)
+	assert.ErrorIs(t, err, ErrPlayerHasNoSuchTile)
+	assert.Equal(t, ErrorCode_NoSuchTile, GetErrorCode(err))
+
+	var ce *CommandError
+	if assert.True(t, errors.As(err, &ce)) {
+		assert.Equal(t, RecordAction_Discard, ce.Command)
+		assert.Equal(t, ps.Idx, ce.Player)
+		assert.Equal(t, tile, ce.Tile)
+		assert.Equal(t, g.gs.Status.CurrentEvent, ce.Event)
+		assert.Contains(t, ce.AllowedActions, "discard")
+		assert.Contains(t, err.Error(), "tile "+tile)
+	}
+}
+
+func Test_CommandError_InvalidReaction(t *testing.T) {
+
+	g := newTestGameForDiscard(t)
+
+	err := g.React(0, "pung", []string{})
+	assert.ErrorIs(t, err, ErrInvalidGameStatus)
+	assert.Equal(t, ErrorCode_InvalidGameStatus, GetErrorCode(err))
+
+	var ce *CommandError
+	if assert.True(t, errors.As(err, &ce)) {
+		assert.Equal(t, RecordAction_React, ce.Command)
+		assert.Equal(t, "pung", ce.Action)
+		assert.Equal(t, GetGameEventSymbols(GameEvent_WaitForPlayerToDiscardTile), ce.Event)
+	}
+
+	// Rejected commands are not recorded
+	for _, a := range g.GetRecord().Actions {
+		assert.NotEqual(t, RecordAction_React, a.Type)
+	}
+}
+
+func Test_GetErrorCode(t *testing.T) {
+
+	assert.Equal(t, ErrorCode_InvalidAction, GetErrorCode(ErrInvalidAction))
+	assert.Equal(t, ErrorCode(""), GetErrorCode(ErrGameNotFound))
+	assert.Equal(t, ErrorCode(""), GetErrorCode(nil))
+}
@@ -36,6 +36,11 @@ const (
 	GameEvent_WaitForPlayerAction
 	GameEvent_WaitForPlayerToDiscardTile
 	GameEvent_WaitForReaction
+
+	GameEvent_AddedKong
+	GameEvent_FlowerKong
+	GameEvent_EightImmortals
+	GameEvent_SevenRobOne
 )
 
 var GameEventSymbols = map[GameEvent]string{
@@ -48,6 +53,7 @@ var GameEventSymbols = map[GameEvent]string{
 	GameEvent_Cancel:                     "Cancel",
 	GameEvent_Kong:                       "Kong",
 	GameEvent_ConcealedKong:              "ConcealedKong",
+	GameEvent_AddedKong:                  "AddedKong",
 	GameEvent_Drawn:                      "Drawn",
 	GameEvent_FlowerTileDrawn:            "FlowerTileDrawn",
 	GameEvent_TileDiscarded:              "TileDiscarded",
@@ -55,6 +61,9 @@ var GameEventSymbols = map[GameEvent]string{
 	GameEvent_NoMoreTiles:                "NoMoreTiles",
 	GameEvent_GameDrawn:                  "GameDrawn",
 	GameEvent_Win:                        "Win",
+	GameEvent_FlowerKong:                 "FlowerKong",
+	GameEvent_EightImmortals:             "EightImmortals",
+	GameEvent_SevenRobOne:                "SevenRobOne",
 	GameEvent_Settlement:                 "Settlement",
 	GameEvent_GameClosed:                 "GameClosed",
 	GameEvent_WaitForReady:               "WaitForReady",
@@ -73,6 +82,7 @@ var GameEventBySymbol = map[string]GameEvent{
 	"Cancel":                     GameEvent_Cancel,
 	"Kong":                       GameEvent_Kong,
 	"ConcealedKong":              GameEvent_ConcealedKong,
+	"AddedKong":                  GameEvent_AddedKong,
 	"Drawn":                      GameEvent_Drawn,
 	"FlowerTileDrawn":            GameEvent_FlowerTileDrawn,
 	"TileDiscarded":              GameEvent_TileDiscarded,
@@ -80,6 +90,9 @@ var GameEventBySymbol = map[string]GameEvent{
 	"NoMoreTiles":                GameEvent_NoMoreTiles,
 	"GameDrawn":                  GameEvent_GameDrawn,
 	"Win":                        GameEvent_Win,
+	"FlowerKong":                 GameEvent_FlowerKong,
+	"EightImmortals":             GameEvent_EightImmortals,
+	"SevenRobOne":                GameEvent_SevenRobOne,
 	"Settlement":                 GameEvent_Settlement,
 	"GameClosed":                 GameEvent_GameClosed,
 	"WaitForReady":               GameEvent_WaitForReady,
@@ -92,8 +105,25 @@ func GetGameEventSymbols(ge GameEvent) string {
 	return GameEventSymbols[ge]
 }
 
+// triggerEvent runs event, state is saved after the first event which was triggered by a command has been done
 func (g *Game) triggerEvent(ge GameEvent, payload interface{}) error {
 
+	g.eventDepth++
+	err := g.handleEvent(ge, payload)
+	g.eventDepth--
+
+	if err != nil || g.eventDepth > 0 {
+		return err
+	}
+
+	g.recordCommand()
+	g.gs.Revision++
+
+	return g.save()
+}
+
+func (g *Game) handleEvent(ge GameEvent, payload interface{}) error {
+
 	g.gs.Status.CurrentEvent = GameEventSymbols[ge]
 	g.gs.UpdatedAt = time.Now().Unix()
 
@@ -116,6 +146,8 @@ func (g *Game) triggerEvent(ge GameEvent, payload interface{}) error {
 		return g.onKong(payload)
 	case GameEvent_ConcealedKong:
 		return g.onConcealedKong(payload)
+	case GameEvent_AddedKong:
+		return g.onAddedKong(payload)
 	case GameEvent_Drawn:
 		return g.onDrawn(payload)
 	case GameEvent_FlowerTileDrawn:
@@ -130,6 +162,12 @@ func (g *Game) triggerEvent(ge GameEvent, payload interface{}) error {
 		return g.onGameDrawn(payload)
 	case GameEvent_Win:
 		return g.onWin(payload)
+	case GameEvent_FlowerKong:
+		return g.onFlowerKong(payload)
+	case GameEvent_EightImmortals:
+		return g.onEightImmortals(payload)
+	case GameEvent_SevenRobOne:
+		return g.onSevenRobOne(payload)
 	case GameEvent_Settlement:
 		return g.onSettlement(payload)
 
@@ -152,6 +190,24 @@ func (g *Game) onGameInitialized(payload interface{}) error {
 }
 
 func (g *Game) onReady(payload interface{}) error {
+
+	// Sets of flowers which players were dealt
+	if g.gs.Meta.FlowerRules.FlowerKong {
+		if kongs := g.figureDealtFlowerKongs(); len(kongs) > 0 {
+			return g.triggerEvent(GameEvent_FlowerKong, kongs[0])
+		}
+	}
+
+	return g.startWithDealtTiles()
+}
+
+func (g *Game) startWithDealtTiles() error {
+
+	// Players might win with flowers they were dealt
+	if won, err := g.checkFlowerWin(); won {
+		return err
+	}
+
 	return g.StartAtBanker()
 }
 
@@ -160,10 +216,12 @@ func (g *Game) onPlayerSelected(payload interface{}) error {
 }
 
 func (g *Game) onChow(payload interface{}) error {
+	g.gs.Status.AfterKong = false
 	return g.WaitForPlayerToDiscardTile()
 }
 
 func (g *Game) onPung(payload interface{}) error {
+	g.gs.Status.AfterKong = false
 	return g.WaitForPlayerToDiscardTile()
 }
 
@@ -172,21 +230,85 @@ func (g *Game) onCancel(payload interface{}) error {
 }
 
 func (g *Game) onKong(payload interface{}) error {
+	g.gs.Status.AfterKong = true
 	return g.DrawSupplementTile()
 }
 
 func (g *Game) onConcealedKong(payload interface{}) error {
+	g.gs.Status.AfterKong = true
 	return g.DrawSupplementTile()
 }
 
+func (g *Game) onAddedKong(payload interface{}) error {
+
+	tile, ok := payload.(string)
+	if !ok {
+		return ErrInvalidEventPayload
+	}
+
+	g.gs.Status.AfterKong = true
+
+	return g.WaitForRobbingKong(tile)
+}
+
 func (g *Game) onDrawn(payload interface{}) error {
 	return g.WaitForPlayerAction()
 }
 
 func (g *Game) onFlowerTileDrawn(payload interface{}) error {
+
+	tile, ok := payload.(string)
+	if !ok {
+		return ErrInvalidEventPayload
+	}
+
+	if won, err := g.checkFlowerWin(); won {
+		return err
+	}
+
+	// The tile completes a set of flowers
+	if g.gs.Meta.FlowerRules.FlowerKong {
+		if set := g.getFlowerKongCompletedBy(tile); set != nil {
+			return g.triggerEvent(GameEvent_FlowerKong, &GameEventPayload_FlowerKong{
+				Player: g.gs.Status.CurrentPlayer,
+				Tiles:  set,
+			})
+		}
+	}
+
 	return g.DrawSupplementTile()
 }
 
+func (g *Game) onFlowerKong(payload interface{}) error {
+
+	p, ok := payload.(*GameEventPayload_FlowerKong)
+	if !ok {
+		return ErrInvalidEventPayload
+	}
+
+	if !p.IsDealt {
+		return g.DrawSupplementTile()
+	}
+
+	// Next set of flowers which were dealt
+	kongs := g.figureDealtFlowerKongs()
+	for i, k := range kongs {
+		if k.Player == p.Player && k.Tiles[0] == p.Tiles[0] && i+1 < len(kongs) {
+			return g.triggerEvent(GameEvent_FlowerKong, kongs[i+1])
+		}
+	}
+
+	return g.startWithDealtTiles()
+}
+
+func (g *Game) onEightImmortals(payload interface{}) error {
+	return g.triggerEvent(GameEvent_Win, payload)
+}
+
+func (g *Game) onSevenRobOne(payload interface{}) error {
+	return g.triggerEvent(GameEvent_Win, payload)
+}
+
 func (g *Game) onTileDiscarded(tile interface{}) error {
 	return g.WaitForReaction()
 }
@@ -223,8 +345,23 @@ func (g *Game) onWin(payload interface{}) error {
 		Winners:          make(map[int]WinnerResult),
 	}
 
+	pc := g.getPointCalculator()
+
 	for _, winnerIdx := range p.Winners {
-		g.gs.Result.Winners[winnerIdx] = WinnerResult{}
+
+		ps := g.GetPlayer(winnerIdx)
+		if ps == nil {
+			return ErrInvalidPlayer
+		}
+
+		wc := g.newWinContext(winnerIdx, p)
+		hand := g.getWinningHand(ps, wc)
+		wc.WildTiles = FigureWildTiles(g.gs.Meta.TileSetDef, hand)
+
+		result := pc.Calculate(g, ps, hand, wc)
+		result.Context = wc
+
+		g.gs.Result.Winners[winnerIdx] = result
 	}
 
 	return g.DoSettlement()
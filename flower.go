@@ -0,0 +1,199 @@
+package foursquare
+
+import (
+	"fmt"
+)
+
+type FlowerRules struct {
+	SeatFlower     bool `json:"seat_flower"`     // 正花，instead of 見花見台
+	FlowerKong     bool `json:"flower_kong"`     // 花槓
+	EightImmortals bool `json:"eight_immortals"` // 八仙過海
+	SevenRobOne    bool `json:"seven_rob_one"`   // 七搶一
+}
+
+var (
+	FlowerSets = [][]string{
+		{"F1", "F2", "F3", "F4"}, // 梅蘭竹菊
+		{"S1", "S2", "S3", "S4"}, // 春夏秋冬
+	}
+)
+
+// FigureSeatFlowers returns flower and season tiles which belong to seat wind
+func FigureSeatFlowers(wind string) []string {
+
+	if len(wind) != 2 {
+		return []string{}
+	}
+
+	num := wind[1:]
+
+	return []string{
+		fmt.Sprintf("%s%s", TileSuitFlower, num),
+		fmt.Sprintf("%s%s", TileSuitSeason, num),
+	}
+}
+
+// CountFlowerKongs returns number of complete sets of flowers or seasons
+func CountFlowerKongs(flowers []string) int {
+
+	count := 0
+	for _, set := range FlowerSets {
+		if isFlowerSetCompleted(flowers, set) {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (g *Game) getFlowerTileCount() int {
+
+	def := g.gs.Meta.TileSetDef
+	if def == nil {
+		def = StandardSetOfTiles
+	}
+
+	count := 0
+	for _, td := range def.Suits {
+		if td.Suit == TileSuitFlower || td.Suit == TileSuitSeason {
+			count += td.Numbers * td.Count
+		}
+	}
+
+	return count
+}
+
+// FilterFlowerSetTiles returns flower and season tiles, other bonus tiles are ignored
+func FilterFlowerSetTiles(flowers []string) []string {
+
+	tiles := make([]string, 0, len(flowers))
+	for _, t := range flowers {
+		for _, set := range FlowerSets {
+			if ContainsTile(set, t) {
+				tiles = append(tiles, t)
+				break
+			}
+		}
+	}
+
+	return tiles
+}
+
+func isFlowerSetCompleted(flowers []string, set []string) bool {
+
+	for _, t := range set {
+		if !ContainsTile(flowers, t) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getFlowerKongCompletedBy returns set of flowers which tile completes, it is nil if set is not completed
+func (g *Game) getFlowerKongCompletedBy(tile string) []string {
+
+	ps := g.GetCurrentPlayer()
+
+	for _, set := range FlowerSets {
+
+		if !ContainsTile(set, tile) {
+			continue
+		}
+
+		if isFlowerSetCompleted(ps.Hand.Flowers, set) {
+			return set
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+// figureDealtFlowerKongs returns sets of flowers which players were dealt, banker goes first
+func (g *Game) figureDealtFlowerKongs() []*GameEventPayload_FlowerKong {
+
+	kongs := make([]*GameEventPayload_FlowerKong, 0)
+
+	for _, ps := range g.getPlayersStartingFrom(g.gs.Meta.Banker) {
+		for _, set := range FlowerSets {
+
+			if !isFlowerSetCompleted(ps.Hand.Flowers, set) {
+				continue
+			}
+
+			kongs = append(kongs, &GameEventPayload_FlowerKong{
+				Player:  ps.Idx,
+				Tiles:   set,
+				IsDealt: true,
+			})
+		}
+	}
+
+	return kongs
+}
+
+// checkFlowerWin figures out instant win with flowers, it returns false if nobody wins.
+func (g *Game) checkFlowerWin() (bool, error) {
+
+	rules := g.gs.Meta.FlowerRules
+	total := g.getFlowerTileCount()
+
+	if total == 0 {
+		return false, nil
+	}
+
+	for i := range g.gs.Players {
+
+		ps := &g.gs.Players[i]
+		flowers := FilterFlowerSetTiles(ps.Hand.Flowers)
+		count := len(flowers)
+
+		// 八仙過海
+		if count == total && rules.EightImmortals {
+
+			payload := &GameEventPayload_Win{
+				DiscardingPlayer: ps.Idx,
+				WinningTile:      flowers[count-1],
+				Winners:          []int{ps.Idx},
+				IsEightImmortals: true,
+			}
+
+			return true, g.triggerEvent(GameEvent_EightImmortals, payload)
+		}
+
+		if count != total-1 || !rules.SevenRobOne {
+			continue
+		}
+
+		// 七搶一: take the last flower from other player
+		for j := range g.gs.Players {
+
+			other := &g.gs.Players[j]
+			if other.Idx == ps.Idx {
+				continue
+			}
+
+			otherFlowers := FilterFlowerSetTiles(other.Hand.Flowers)
+			if len(otherFlowers) != 1 {
+				continue
+			}
+
+			tile := otherFlowers[0]
+			other.Hand.Flowers, _ = RemoveTiles(other.Hand.Flowers, []string{tile})
+			ps.Hand.Flowers = append(ps.Hand.Flowers, tile)
+
+			payload := &GameEventPayload_Win{
+				DiscardingPlayer: other.Idx,
+				WinningTile:      tile,
+				Winners:          []int{ps.Idx},
+				IsSevenRobOne:    true,
+			}
+
+			return true, g.triggerEvent(GameEvent_SevenRobOne, payload)
+		}
+	}
+
+	return false, nil
+}
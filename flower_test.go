@@ -0,0 +1,206 @@
+package foursquare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Flower_FigureSeatFlowers(t *testing.T) {
+	assert.ElementsMatch(t, []string{"F1", "S1"}, FigureSeatFlowers("I1"))
+	assert.ElementsMatch(t, []string{"F3", "S3"}, FigureSeatFlowers("I3"))
+}
+
+func Test_Flower_CountFlowerKongs(t *testing.T) {
+	assert.Equal(t, 0, CountFlowerKongs([]string{"F1", "F2", "F3", "S4"}))
+	assert.Equal(t, 1, CountFlowerKongs([]string{"F1", "F2", "F3", "F4", "S4"}))
+	assert.Equal(t, 2, CountFlowerKongs([]string{"F1", "F2", "F3", "F4", "S1", "S2", "S3", "S4"}))
+}
+
+func Test_Flower_EightImmortals(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+	opts.FlowerRules.EightImmortals = true
+
+	g := NewGame(opts)
+	assert.Nil(t, g.StartGame())
+
+	// Player was dealt all flowers
+	player := g.GetPlayer(2)
+	player.Hand.Flowers = []string{"F1", "F2", "F3", "F4", "S1", "S2", "S3", "S4"}
+
+	assert.Nil(t, g.Ready())
+	assert.Equal(t, g.gs.Status.CurrentEvent, GetGameEventSymbols(GameEvent_GameClosed))
+
+	result := g.gs.Result.Winners[2]
+	assert.True(t, result.Context.IsEightImmortals)
+	assert.True(t, result.Context.IsSelfDrawn)
+	assert.Equal(t, 8, result.Conditions[EightImmortals])
+	assert.Equal(t, 8, result.Conditions[FlowerTiles])
+	assert.Zero(t, result.Conditions[ConcealedHand])
+
+	// Everybody pays
+	assert.Equal(t, -(100 + 16*20), g.gs.Result.Deltas[1])
+	assert.Equal(t, -(100 + 16*20 + 20), g.gs.Result.Deltas[0])
+}
+
+func Test_Flower_SevenRobOne(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+	opts.FlowerRules.SeatFlower = true
+	opts.FlowerRules.FlowerKong = true
+	opts.FlowerRules.SevenRobOne = true
+
+	g := NewGame(opts)
+	assert.Nil(t, g.InitializeGame())
+
+	// Second player has seven flowers
+	player := g.GetPlayer(1)
+	player.Hand.Flowers = []string{"F1", "F2", "F3", "F4", "S1", "S2", "S3"}
+
+	// Banker draws the last one
+	g.gs.Meta.Tiles[g.gs.Status.CurrentTileSetPosition] = "S4"
+	assert.Nil(t, g.StartAtBanker())
+
+	assert.Equal(t, g.gs.Status.CurrentEvent, GetGameEventSymbols(GameEvent_GameClosed))
+	assert.Equal(t, 0, len(g.GetPlayer(0).Hand.Flowers))
+	assert.Equal(t, 8, len(player.Hand.Flowers))
+
+	result := g.gs.Result.Winners[1]
+	assert.True(t, result.Context.IsSevenRobOne)
+	assert.Equal(t, 0, result.Context.SourcePlayer)
+	assert.Equal(t, 8, result.Conditions[SevenRobOne])
+	assert.Equal(t, 4, result.Conditions[FlowerKong])
+	assert.Equal(t, 2, result.Conditions[SeatFlower]) // F2 and S2 for south
+	assert.Zero(t, result.Conditions[FlowerTiles])
+	assert.Equal(t, 1+2*0, result.Conditions[Banker])
+
+	// Banker pays
+	assert.Equal(t, -(100 + result.Points*20), g.gs.Result.Deltas[0])
+	assert.Equal(t, 0, g.gs.Result.Deltas[2])
+}
+
+func Test_Flower_FlowerKong(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+	opts.FlowerRules.FlowerKong = true
+
+	g := NewGame(opts)
+	assert.Nil(t, g.InitializeGame())
+
+	banker := g.GetPlayer(0)
+	banker.Hand.Flowers = []string{"F1", "F2", "F3"}
+
+	// Banker draws the last flower of set, then draws supplement tile
+	g.gs.Meta.Tiles[g.gs.Status.CurrentTileSetPosition] = "F4"
+	g.gs.Meta.Tiles[g.gs.Status.CurrentSupplementPosition] = "W9"
+	assert.Nil(t, g.StartAtBanker())
+
+	assert.Equal(t, g.gs.Status.CurrentEvent, GetGameEventSymbols(GameEvent_WaitForPlayerToDiscardTile))
+	assert.ElementsMatch(t, []string{"F1", "F2", "F3", "F4"}, banker.Hand.Flowers)
+	assert.Equal(t, []string{"W9"}, banker.Hand.Draw)
+
+	pc := NewPointCalculator(StandardRules)
+	assert.Equal(t, 2, pc.FlowerKong(banker.Hand))
+	assert.Equal(t, 1, pc.SeatFlower(banker.Wind, banker.Hand))
+}
+
+func Test_Flower_SevenRobOne_IgnoresNorthWind(t *testing.T) {
+
+	opts := NewOptionsWithRuleSet(ThreePlayerRuleSet)
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(opts.TileSetDef)
+	opts.FlowerRules.SevenRobOne = true
+
+	g := NewGame(opts)
+	assert.Nil(t, g.InitializeGame())
+
+	g.GetPlayer(0).Hand.Flowers = []string{"I4", "I4", "S4"}
+	g.GetPlayer(1).Hand.Flowers = []string{"F1", "F2", "F3", "F4", "S1", "S2", "S3", "I4"}
+	g.GetPlayer(2).Hand.Flowers = []string{}
+
+	ok, err := g.checkFlowerWin()
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	assert.ElementsMatch(t, []string{"I4", "I4"}, g.GetPlayer(0).Hand.Flowers)
+	assert.True(t, g.gs.Result.Winners[1].Context.IsSevenRobOne)
+}
+
+func Test_Flower_BonusSuitOfTileSet(t *testing.T) {
+
+	opts := NewOptions()
+	opts.TileSetDef = &TileSetDef{
+		Suits: append(append([]TileDef{}, StandardSetOfTiles.Suits...), TileDef{"A", TileKindBonus, 4, 1, nil}),
+	}
+	opts.Dices = RollDices()
+	opts.Tiles = ShuffleTiles(NewTileSet(opts.TileSetDef))
+
+	g := NewGame(opts)
+	assert.Nil(t, g.InitializeGame())
+
+	// Animal tiles are set aside like flowers
+	for _, ps := range g.gs.Players {
+		for _, tile := range ps.Hand.Tiles {
+			assert.NotEqual(t, "A", tile[0:1])
+		}
+	}
+}
+
+func Test_Flower_InstantWinsAreOffByDefault(t *testing.T) {
+
+	opts := NewOptions()
+	assert.False(t, opts.FlowerRules.EightImmortals)
+	assert.False(t, opts.FlowerRules.SevenRobOne)
+
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+	g := NewGame(opts)
+	assert.Nil(t, g.StartGame())
+
+	g.GetPlayer(2).Hand.Flowers = []string{"F1", "F2", "F3", "F4", "S1", "S2", "S3", "S4"}
+
+	// Game goes on
+	assert.Nil(t, g.Ready())
+	assert.Nil(t, g.gs.Result)
+	assert.NotEqual(t, g.gs.Status.CurrentEvent, GetGameEventSymbols(GameEvent_GameClosed))
+}
+
+func Test_Flower_FlowerKong_Dealt(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+	opts.FlowerRules.FlowerKong = true
+
+	g := NewGame(opts)
+	assert.Nil(t, g.StartGame())
+
+	// Players were dealt sets of flowers
+	banker := g.GetPlayer(0)
+	banker.Hand.Flowers = []string{"S1", "S2", "S3", "S4"}
+	g.GetPlayer(2).Hand.Flowers = []string{"F1", "F2", "F3", "F4"}
+
+	kongs := g.figureDealtFlowerKongs()
+	if assert.Len(t, kongs, 2) {
+		assert.Equal(t, &GameEventPayload_FlowerKong{Player: 0, Tiles: FlowerSets[1], IsDealt: true}, kongs[0])
+		assert.Equal(t, &GameEventPayload_FlowerKong{Player: 2, Tiles: FlowerSets[0], IsDealt: true}, kongs[1])
+	}
+
+	// Dealt flowers never get supplement tiles again
+	g.gs.Meta.Tiles[g.gs.Status.CurrentTileSetPosition] = "W9"
+	spos := g.gs.Status.CurrentSupplementPosition
+	count := len(banker.Hand.Tiles)
+
+	assert.Nil(t, g.Ready())
+	assert.Equal(t, spos, g.gs.Status.CurrentSupplementPosition)
+	assert.Equal(t, count+1, len(banker.Hand.Tiles))
+	assert.Equal(t, 0, g.GetCurrentPlayer().Idx)
+}
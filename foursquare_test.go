@@ -169,5 +169,80 @@ func Test_OneDiscard_ThreeWinners(t *testing.T) {
 	// Oops!
 	assert.Equal(t, g.gs.Status.CurrentEvent, GetGameEventSymbols(GameEvent_GameClosed))
 
+	// Everybody wins with D1
+	assert.Equal(t, 3, len(g.gs.Result.Winners))
+	for idx, w := range g.gs.Result.Winners {
+		assert.NotZero(t, w.Points, idx)
+		assert.Equal(t, 1, w.Conditions[ConcealedHand], idx)
+	}
+
+	// Banker declared ready hand
+	assert.Equal(t, 1, g.gs.Result.Winners[0].Conditions[DeclareReadyHand])
+
+	// Discarding player pays for everybody
+	total := 0
+	for idx, w := range g.gs.Result.Winners {
+		amount := opts.BasePoint + w.Points*opts.PointValue
+		assert.Equal(t, amount, g.gs.Result.Deltas[idx], idx)
+		total += amount
+	}
+
+	assert.Equal(t, -total, g.gs.Result.Deltas[1])
+
 	g.PrintState()
 }
+
+func Test_RobbingTheKong(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+	g := NewGame(opts)
+	assert.Nil(t, g.InitializeGame())
+
+	// Banker has a melded pung of W5
+	banker := g.GetPlayer(0)
+	banker.Hand = NewHand()
+	banker.Hand.Triplet = []string{"W5"}
+	banker.Hand.Tiles = []string{
+		"B7", "B8", "B9", "I1", "I1", "I2", "I3", "I4", "D2", "D3", "B5", "W1", "W9",
+	}
+
+	// Second player is waiting for W5
+	player := g.GetPlayer(1)
+	player.Hand = NewHand()
+	player.Hand.Tiles = []string{
+		"W4", "W6", "T1", "T2", "T3", "T4", "T5", "T6", "T7", "T8", "T9", "B1", "B2", "B3", "D1", "D1",
+	}
+
+	// Banker draws W5
+	g.gs.Meta.Tiles[g.gs.Status.CurrentTileSetPosition] = "W5"
+	assert.Nil(t, g.StartAtBanker())
+
+	assert.Equal(t, g.gs.Status.CurrentEvent, GetGameEventSymbols(GameEvent_WaitForPlayerAction))
+	assert.True(t, banker.IsAllowedAction("kong"))
+	assert.Nil(t, g.Act("kong"))
+	assert.ElementsMatch(t, []string{"W5"}, banker.Hand.Kong.Open)
+
+	// Second player is able to rob the kong
+	assert.Equal(t, g.gs.Status.CurrentEvent, GetGameEventSymbols(GameEvent_WaitForReaction))
+	assert.True(t, player.IsAllowedAction("win"))
+	assert.NotNil(t, g.React(2, "win", []string{}))
+	assert.Nil(t, g.React(1, "win", []string{}))
+
+	assert.Equal(t, g.gs.Status.CurrentEvent, GetGameEventSymbols(GameEvent_GameClosed))
+
+	result := g.gs.Result.Winners[1]
+	assert.True(t, result.Context.IsRobbedKong)
+	assert.False(t, result.Context.IsSelfDrawn)
+	assert.False(t, result.Context.IsAfterKong)
+	assert.Equal(t, 0, result.Context.SourcePlayer)
+	assert.Equal(t, 1, result.Conditions[RobbingTheKong])
+	assert.Zero(t, result.Conditions[KongOnDiscard])
+
+	// Kong was robbed, banker has the melded pung only
+	assert.Empty(t, banker.Hand.Kong.Open)
+	assert.Equal(t, []string{"W5"}, banker.Hand.Triplet)
+	assert.Equal(t, 3, CountByTiles(banker.Hand.GetAllTiles())["W5"])
+}
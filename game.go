@@ -22,6 +22,10 @@ var (
 type Game struct {
 	initialHand map[int]*Hand
 	gs          *GameState
+	store       GameStore
+	eventDepth  int
+	command     *RecordAction // Action of command which is running
+	saved       *GameState    // The last state which store has
 }
 
 func NewGame(opts *Options) *Game {
@@ -37,7 +41,17 @@ func NewGame(opts *Options) *Game {
 	g.gs.Meta.Dices = opts.Dices
 	g.gs.Meta.PlayerCount = opts.PlayerCount
 	g.gs.Meta.WinningStreak = opts.WinningStreak
+	g.gs.Meta.BasePoint = opts.BasePoint
+	g.gs.Meta.PointValue = opts.PointValue
+	g.gs.Meta.Banker = opts.Banker
+	g.gs.Meta.PrevailingWind = opts.PrevailingWind
+	g.gs.Meta.BankerStaysOnWin = opts.BankerStaysOnWin
+	g.gs.Meta.BankerStaysOnDraw = opts.BankerStaysOnDraw
+	g.gs.Meta.FlowerRules = opts.FlowerRules
 	g.gs.Meta.Tiles = opts.Tiles
+	g.gs.Meta.PointRules = opts.PointRules
+	g.gs.Meta.RuleSet = opts.RuleSet
+	g.SetStore(opts.Store)
 
 	return g
 }
@@ -89,26 +103,37 @@ func (g *Game) InitializeGame() error {
 	return g.triggerEvent(GameEvent_GameInitialized, nil)
 }
 
-func (g *Game) Ready() error {
+func (g *Game) Ready() (err error) {
+
+	a := RecordAction{Type: RecordAction_Ready, Player: -1}
+	g.startCommand(a)
+
+	defer g.endCommand(&err, a)
+
 	return g.triggerEvent(GameEvent_Ready, nil)
 }
 
 func (g *Game) StartAtBanker() error {
-	g.gs.Status.CurrentPlayer = 0
+	g.gs.Status.CurrentPlayer = g.gs.Meta.Banker
 	return g.triggerEvent(GameEvent_PlayerSelected, nil)
 }
 
 func (g *Game) DrawSupplementTile() error {
 
+	if g.getRemainingTileCount() <= 0 {
+		return g.triggerEvent(GameEvent_NoMoreTiles, nil)
+	}
+
 	ps := g.GetCurrentPlayer()
 
-	tile, flowerTiles := g.drawSupplementTile()
+	tile := g.gs.Meta.Tiles[g.gs.Status.CurrentSupplementPosition]
+	g.gs.Status.CurrentSupplementPosition--
 
-	if tile == "" {
-		return g.triggerEvent(GameEvent_NoMoreTiles, nil)
+	if g.isBonusTile(tile) {
+		ps.Hand.Flowers = append(ps.Hand.Flowers, tile)
+		return g.triggerEvent(GameEvent_FlowerTileDrawn, tile)
 	}
 
-	ps.Hand.Flowers = append(ps.Hand.Flowers, flowerTiles...)
 	ps.Hand.Deal([]string{tile})
 
 	return g.triggerEvent(GameEvent_Drawn, nil)
@@ -116,6 +141,9 @@ func (g *Game) DrawSupplementTile() error {
 
 func (g *Game) Draw() error {
 
+	g.gs.Status.Turn++
+	g.gs.Status.AfterKong = false
+
 	tiles := g.dealTiles(1)
 	if len(tiles) == 0 {
 		return g.triggerEvent(GameEvent_NoMoreTiles, nil)
@@ -123,9 +151,9 @@ func (g *Game) Draw() error {
 
 	ps := g.GetCurrentPlayer()
 
-	if TileSuit(tiles[0][0:1]) == TileSuitFlower {
+	if g.isBonusTile(tiles[0]) {
 		ps.Hand.Flowers = append(ps.Hand.Flowers, tiles...)
-		return g.triggerEvent(GameEvent_FlowerTileDrawn, nil)
+		return g.triggerEvent(GameEvent_FlowerTileDrawn, tiles[0])
 	}
 
 	ps.Hand.Deal(tiles)
@@ -165,10 +193,35 @@ func (g *Game) SelectPlayer(playerIdx int, ctx string) error {
 	return g.triggerEvent(GameEvent_PlayerSelected, ctx)
 }
 
-func (g *Game) Act(action string) error {
+// endCommand records command which has been done, or adds context to error if command was rejected
+func (g *Game) endCommand(err *error, a RecordAction) {
+
+	if *err != nil {
+		*err = g.newCommandError(*err, a)
+
+		if g.eventDepth == 0 {
+			g.command = nil
+		}
+
+		return
+	}
+
+	// Command which changed nothing to save
+	if g.eventDepth == 0 {
+		g.recordCommand()
+	}
+}
+
+func (g *Game) Act(action string) (err error) {
 
 	ps := g.GetCurrentPlayer()
 
+	a := RecordAction{Type: RecordAction_Act, Player: ps.Idx, Action: action}
+	a.Tile = g.getRecordedTile(a)
+	g.startCommand(a)
+
+	defer g.endCommand(&err, a)
+
 	if !ps.IsAllowedAction(action) {
 		return ErrInvalidAction
 	}
@@ -184,7 +237,20 @@ func (g *Game) Act(action string) error {
 
 		return g.triggerEvent(GameEvent_Win, payload)
 	case "kong":
-		err := ps.Hand.DoKong(ps.Hand.Draw[0], true)
+
+		tile := ps.Hand.Draw[0]
+
+		// Add drawn tile to melded pung
+		if ContainsTile(ps.Hand.Triplet, tile) {
+			err := ps.Hand.DoAddedKong(tile)
+			if err != nil {
+				return err
+			}
+
+			return g.triggerEvent(GameEvent_AddedKong, tile)
+		}
+
+		err := ps.Hand.DoKong(tile, true)
 		if err != nil {
 			return err
 		}
@@ -195,7 +261,26 @@ func (g *Game) Act(action string) error {
 	return g.triggerEvent(GameEvent_Cancel, nil)
 }
 
-func (g *Game) React(playerIdx int, reaction string, selectedTiles []string) error {
+func (g *Game) React(playerIdx int, reaction string, selectedTiles []string) (err error) {
+
+	a := RecordAction{Type: RecordAction_React, Player: playerIdx, Action: reaction, Tiles: selectedTiles}
+	if g.gs.Status.CurrentEvent == GameEventSymbols[GameEvent_WaitForReaction] {
+		a.Tile = g.getRecordedTile(a)
+	}
+
+	g.startCommand(a)
+
+	defer g.endCommand(&err, a)
+
+	// Somebody else has reacted already
+	if g.gs.Status.CurrentEvent != GameEventSymbols[GameEvent_WaitForReaction] {
+		return ErrInvalidGameStatus
+	}
+
+	// Players are reacting to added kong
+	if g.gs.Status.AddedKongTile != "" {
+		return g.reactToAddedKong(playerIdx, reaction)
+	}
 
 	// No one has any reactions
 	if playerIdx == -1 {
@@ -236,7 +321,7 @@ func (g *Game) React(playerIdx int, reaction string, selectedTiles []string) err
 		return g.triggerEvent(GameEvent_Win, payload)
 	case "kong":
 
-		err := ps.Hand.DoKong(discardedTile, false)
+		err := ps.Hand.DoOpenKongWithWilds(g.gs.Meta.TileSetDef, discardedTile)
 		if err != nil {
 			return err
 		}
@@ -245,7 +330,7 @@ func (g *Game) React(playerIdx int, reaction string, selectedTiles []string) err
 
 	case "pung":
 
-		err := ps.Hand.DoPung(discardedTile)
+		err := ps.Hand.DoPungWithWilds(g.gs.Meta.TileSetDef, discardedTile)
 		if err != nil {
 			return err
 		}
@@ -265,10 +350,15 @@ func (g *Game) React(playerIdx int, reaction string, selectedTiles []string) err
 	return g.triggerEvent(GameEvent_NoReactions, nil)
 }
 
-func (g *Game) DiscardTile(tile string) error {
+func (g *Game) DiscardTile(tile string) (err error) {
 
 	ps := g.GetCurrentPlayer()
 
+	a := RecordAction{Type: RecordAction_Discard, Player: ps.Idx, Tile: tile}
+	g.startCommand(a)
+
+	defer g.endCommand(&err, a)
+
 	if !ps.IsAllowedAction("discard") {
 		return ErrInvalidAction
 	}
@@ -282,10 +372,15 @@ func (g *Game) DiscardTile(tile string) error {
 	return g.triggerEvent(GameEvent_TileDiscarded, nil)
 }
 
-func (g *Game) ReadyHand(tile string) error {
+func (g *Game) ReadyHand(tile string) (err error) {
 
 	ps := g.GetCurrentPlayer()
 
+	a := RecordAction{Type: RecordAction_ReadyHand, Player: ps.Idx, Tile: tile}
+	g.startCommand(a)
+
+	defer g.endCommand(&err, a)
+
 	if !ps.IsAllowedAction("readyhand") {
 		return ErrInvalidAction
 	}
@@ -307,7 +402,10 @@ func (g *Game) DrawGame() error {
 
 // DoSettlement 牌局結算
 func (g *Game) DoSettlement() error {
-	// 實現牌局結算的邏輯
+
+	// Winners get paid with base and points
+	g.settle()
+
 	return g.triggerEvent(GameEvent_Settlement, nil)
 }
 
@@ -326,15 +424,15 @@ func (g *Game) WaitForPlayerToDiscardTile() error {
 	ps := g.GetCurrentPlayer()
 	ps.ResetAllowedActions()
 
-	// Discard tile directly if player stay in ready hand condition
-	if ps.IsReadyHand {
-		return g.DiscardTile(ps.Hand.Draw[0])
-	}
-
 	ps.AllowAction(&Action{
 		Name: "discard",
 	})
 
+	// Discard tile directly if player stay in ready hand condition
+	if ps.IsReadyHand && len(ps.Hand.Draw) > 0 {
+		return g.DiscardTile(ps.Hand.Draw[0])
+	}
+
 	// Figure discard candidates for readyhand condition
 	candidates := FigureDiscardCandidatesForReadyHand(g.gs.Meta.TileSetDef, ps.Hand.Tiles)
 	if len(candidates) > 0 {
@@ -353,7 +451,7 @@ func (g *Game) WaitForPlayerAction() error {
 	ps.ResetAllowedActions()
 
 	// Figure out actions
-	actions := ps.Hand.FigureActions()
+	actions := ps.Hand.FigureActionsWithTileSet(g.gs.Meta.TileSetDef)
 	if len(actions) == 0 {
 
 		// No Actions
@@ -388,7 +486,7 @@ func (g *Game) WaitForReaction() error {
 		}
 
 		// Assign allowed actions for player
-		actions := p.Hand.FigureReactions(g.gs.Meta.TileSetDef, discardedTile, i)
+		actions := p.Hand.FigureReactionsWithRuleSet(g.gs.Meta.TileSetDef, discardedTile, i, g.getRuleSet())
 		if len(actions) > 0 {
 			hasReactors = true
 			p.AllowActions(actions)
@@ -425,6 +523,38 @@ func (g *Game) WaitForReaction() error {
 	return g.triggerEvent(GameEvent_NoReactions, nil)
 }
 
+func (g *Game) WaitForRobbingKong(tile string) error {
+
+	ps := g.GetCurrentPlayer()
+
+	// Figure out players who are able to win with tile of kong
+	hasRobbers := false
+	for _, p := range g.getPlayersStartingFrom(ps.Idx) {
+
+		p.ResetAllowedActions()
+
+		if p.Idx == ps.Idx {
+			continue
+		}
+
+		tiles := append(append([]string{}, p.Hand.Tiles...), tile)
+
+		state := Resolve(g.gs.Meta.TileSetDef, tiles)
+		if state.IsWin {
+			hasRobbers = true
+			p.AllowAction(&Action{Name: "win"})
+		}
+	}
+
+	if !hasRobbers {
+		return g.DrawSupplementTile()
+	}
+
+	g.gs.Status.AddedKongTile = tile
+
+	return g.triggerEvent(GameEvent_WaitForReaction, nil)
+}
+
 func (g *Game) GetState() *GameState {
 	return g.gs
 }
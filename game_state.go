@@ -1,13 +1,19 @@
 package foursquare
 
+import (
+	"encoding/json"
+)
+
 type GameState struct {
-	GameID    string        `json:"game_id"`
-	CreatedAt int64         `json:"created_at"`
-	UpdatedAt int64         `json:"updated_at"`
-	Meta      Meta          `json:"meta"`
-	Players   []PlayerState `json:"players"`
-	Status    Status        `json:"status"`
-	Result    *Result       `json:"result,omitempty"`
+	GameID    string         `json:"game_id"`
+	CreatedAt int64          `json:"created_at"`
+	UpdatedAt int64          `json:"updated_at"`
+	Revision  int64          `json:"revision"` // Increased by each command, store refuses to save state which is out of date
+	Meta      Meta           `json:"meta"`
+	Players   []PlayerState  `json:"players"`
+	Status    Status         `json:"status"`
+	Result    *Result        `json:"result,omitempty"`
+	Actions   []RecordAction `json:"actions,omitempty"` // Commands which were played, they make up game record
 }
 
 type Meta struct {
@@ -15,13 +21,25 @@ type Meta struct {
 	HandTileCount int         `json:"handtile_count"`
 	PlayerCount   int         `json:"player_count"`
 	WinningStreak int         `json:"winning_streak"`
+	BasePoint     int         `json:"base_point"`
+	PointValue    int         `json:"point_value"`
 	Dices         []int       `json:"dices"`
 	Tiles         []string    `json:"tiles"`
+
+	Banker            int    `json:"banker"`
+	PrevailingWind    string `json:"prevailing_wind"`
+	BankerStaysOnWin  bool   `json:"banker_stays_on_win"`
+	BankerStaysOnDraw bool   `json:"banker_stays_on_draw"`
+
+	PointRules  map[PointType]PointRule `json:"point_rules,omitempty"`
+	FlowerRules FlowerRules             `json:"flower_rules"`
+	RuleSet     *RuleSet                `json:"ruleset,omitempty"`
 }
 
 type PlayerState struct {
 	Idx            int       `json:"idx"`
 	IsBanker       bool      `json:"is_banker"`
+	Wind           string    `json:"wind"`
 	IsReadyHand    bool      `json:"is_ready_hand"`
 	Hand           *Hand     `json:"hand"`
 	AllowedActions []*Action `json:"allowed_actions"`
@@ -33,6 +51,9 @@ type Status struct {
 	CurrentSupplementPosition int      `json:"cur_spos"`
 	CurrentPlayer             int      `json:"cur_player"`
 	DiscardArea               []string `json:"discard_area"`
+	Turn                      int      `json:"turn"`
+	AfterKong                 bool     `json:"after_kong"`
+	AddedKongTile             string   `json:"added_kong_tile,omitempty"`
 }
 
 type Result struct {
@@ -40,17 +61,51 @@ type Result struct {
 	DiscardingPlayer int                  `json:"discarding_player,omitempty"`
 	WinningTile      string               `json:"winning_tile,omitempty"`
 	Winners          map[int]WinnerResult `json:"winners,omitempty"`
+	Payments         []Payment            `json:"payments,omitempty"`
+	Deltas           map[int]int          `json:"deltas,omitempty"`
+
+	// 連莊：banker keeps position in next hand if it is greater than zero
+	NextWinningStreak int `json:"next_winning_streak"`
 }
 
 type WinnerResult struct {
 	Points     int               `json:"points"`
 	Conditions map[PointType]int `json:"conditions"`
+	Context    *WinContext       `json:"context,omitempty"`
 }
 
 func NewGameState() *GameState {
 	return &GameState{}
 }
 
+// GetRemainingTileCount returns number of tiles which are still in the wall
+func (gs *GameState) GetRemainingTileCount() int {
+
+	// No wall before tiles were dealt
+	if len(gs.Meta.Tiles) == 0 {
+		return 0
+	}
+
+	return gs.Status.CurrentSupplementPosition - gs.Status.CurrentTileSetPosition + 1
+}
+
+// Clone returns a deep copy of game state
+func (gs *GameState) Clone() *GameState {
+
+	data, err := json.Marshal(gs)
+	if err != nil {
+		return nil
+	}
+
+	var state GameState
+	err = json.Unmarshal(data, &state)
+	if err != nil {
+		return nil
+	}
+
+	return &state
+}
+
 func (ps *PlayerState) IsAllowedAction(action string) bool {
 
 	for _, a := range ps.AllowedActions {
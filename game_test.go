@@ -67,3 +67,59 @@ func Test_Game_WaitForReady(t *testing.T) {
 
 	assert.Equal(t, g.gs.Status.CurrentEvent, GetGameEventSymbols(GameEvent_WaitForReady))
 }
+
+func Test_Game_WaitForPlayerAction_HonorTiles(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+	g := NewGame(opts)
+	assert.Nil(t, g.InitializeGame())
+
+	// Banker is waiting for D1 which makes a pung of honor tiles
+	banker := g.GetPlayer(0)
+	banker.Hand = NewHand()
+	banker.Hand.Tiles = []string{
+		"W1", "W2", "W3", "W4", "W5", "W6", "T1", "T2", "T3", "T4", "T5", "T6", "B1", "B1", "D1", "D1",
+	}
+
+	g.gs.Meta.Tiles[g.gs.Status.CurrentTileSetPosition] = "D1"
+	assert.Nil(t, g.StartAtBanker())
+
+	assert.Equal(t, g.gs.Status.CurrentEvent, GetGameEventSymbols(GameEvent_WaitForPlayerAction))
+	assert.True(t, banker.IsAllowedAction("win"))
+
+	assert.Nil(t, g.Act("win"))
+	assert.Equal(t, g.gs.Status.CurrentEvent, GetGameEventSymbols(GameEvent_GameClosed))
+	assert.Contains(t, g.gs.Result.Winners, 0)
+}
+
+func Test_Game_WaitForPlayerToDiscardTile_ReadyHand(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+	g := NewGame(opts)
+	assert.Nil(t, g.InitializeGame())
+
+	// Second player declared ready hand and waits for D1
+	player := g.GetPlayer(1)
+	player.IsReadyHand = true
+	player.Hand = NewHand()
+	player.Hand.Tiles = []string{
+		"W1", "W2", "W3", "W4", "W5", "W6", "T1", "T2", "T3", "T4", "T5", "T6", "B1", "B1", "D1", "D1",
+	}
+
+	// Player draws a tile which is useless
+	g.gs.Status.CurrentPlayer = 1
+	g.gs.Meta.Tiles[g.gs.Status.CurrentTileSetPosition] = "W9"
+	assert.Nil(t, g.Draw())
+
+	// The tile was discarded directly
+	assert.NotContains(t, player.Hand.Tiles, "W9")
+	assert.Equal(t, 16, len(player.Hand.Tiles))
+	assert.Equal(t, "W9", g.gs.Status.DiscardArea[len(g.gs.Status.DiscardArea)-1])
+	assert.NotEqual(t, g.gs.Status.CurrentEvent, GetGameEventSymbols(GameEvent_WaitForPlayerToDiscardTile))
+}
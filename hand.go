@@ -18,6 +18,7 @@ type Hand struct {
 	Kong     Kong       `json:"kong"`
 	Tiles    []string   `json:"tiles"`
 	Draw     []string   `json:"draw"`
+	Wilds    []string   `json:"wilds,omitempty"` // Wild tiles which stand for tiles of melded pungs and kongs
 }
 
 func NewHand() *Hand {
@@ -34,6 +35,27 @@ func NewHand() *Hand {
 	}
 }
 
+func (h *Hand) Clone() *Hand {
+
+	hand := NewHand()
+	hand.Flowers = append(hand.Flowers, h.Flowers...)
+	hand.Triplet = append(hand.Triplet, h.Triplet...)
+	hand.Kong.Open = append(hand.Kong.Open, h.Kong.Open...)
+	hand.Kong.Concealed = append(hand.Kong.Concealed, h.Kong.Concealed...)
+	hand.Tiles = append(hand.Tiles, h.Tiles...)
+	hand.Draw = append(hand.Draw, h.Draw...)
+
+	if len(h.Wilds) > 0 {
+		hand.Wilds = append([]string{}, h.Wilds...)
+	}
+
+	for _, s := range h.Straight {
+		hand.Straight = append(hand.Straight, append([]string{}, s...))
+	}
+
+	return hand
+}
+
 func (h *Hand) GetAllTiles() []string {
 
 	var tiles []string
@@ -60,6 +82,17 @@ func (h *Hand) GetAllTiles() []string {
 	return tiles
 }
 
+// HasPungOrKong checks if there is pung or kong of tile in hand, it works for honor tiles only
+// for concealed tiles because they are impossible to be a part of straight.
+func (h *Hand) HasPungOrKong(tile string) bool {
+
+	if ContainsTile(h.Triplet, tile) || ContainsTile(h.Kong.Open, tile) || ContainsTile(h.Kong.Concealed, tile) {
+		return true
+	}
+
+	return CountSpecificTile(h.Tiles, tile) >= 3
+}
+
 func (h *Hand) Deal(tiles []string) {
 	h.Draw = tiles
 	h.Tiles = append(h.Tiles, tiles...)
@@ -128,6 +161,45 @@ func (h *Hand) DoPung(tile string) error {
 	return nil
 }
 
+// DoPungWithWilds is DoPung which makes up missing tiles with wild tiles if rule allows
+func (h *Hand) DoPungWithWilds(tileSetDef *TileSetDef, tile string) error {
+
+	if tile == "" {
+		return ErrInvalidAction
+	}
+
+	newTiles, wilds, ok := takeTilesWithWilds(tileSetDef, h.Tiles, tile, 2)
+	if !ok {
+		return ErrInvalidAction
+	}
+
+	h.Tiles = newTiles
+	h.Triplet = append(h.Triplet, tile)
+	h.Wilds = append(h.Wilds, wilds...)
+
+	return nil
+}
+
+// DoOpenKongWithWilds takes discarded tile as kong, missing tiles are made up with wild tiles if rule allows
+func (h *Hand) DoOpenKongWithWilds(tileSetDef *TileSetDef, tile string) error {
+
+	if tile == "" {
+		return ErrInvalidAction
+	}
+
+	newTiles, wilds, ok := takeTilesWithWilds(tileSetDef, h.Tiles, tile, 3)
+	if !ok {
+		return ErrInvalidAction
+	}
+
+	h.Tiles = newTiles
+	h.Kong.Open = append(h.Kong.Open, tile)
+	h.Wilds = append(h.Wilds, wilds...)
+	h.Draw = []string{}
+
+	return nil
+}
+
 func (h *Hand) DoKong(tile string, isConcealed bool) error {
 
 	if tile == "" {
@@ -156,6 +228,51 @@ func (h *Hand) DoKong(tile string, isConcealed bool) error {
 	return nil
 }
 
+func (h *Hand) DoAddedKong(tile string) error {
+
+	if tile == "" {
+		return ErrInvalidAction
+	}
+
+	idx := -1
+	for i, t := range h.Triplet {
+		if t == tile {
+			idx = i
+			break
+		}
+	}
+
+	if idx == -1 {
+		return ErrInvalidAction
+	}
+
+	newTiles, n := RemoveTiles(h.Tiles, []string{tile})
+	if n != 1 {
+		return ErrInvalidAction
+	}
+
+	h.Tiles = newTiles
+	h.Triplet = append(h.Triplet[:idx], h.Triplet[idx+1:]...)
+	h.Kong.Open = append(h.Kong.Open, tile)
+	h.Draw = []string{}
+
+	return nil
+}
+
+// undoAddedKong turns added kong back to melded pung, the tile was robbed by other player (搶槓)
+func (h *Hand) undoAddedKong(tile string) error {
+
+	newKongs, n := RemoveTiles(h.Kong.Open, []string{tile})
+	if n != 1 {
+		return ErrInvalidAction
+	}
+
+	h.Kong.Open = newKongs
+	h.Triplet = append(h.Triplet, tile)
+
+	return nil
+}
+
 func (h *Hand) FigureStraightCandidate(tile string) [][]string {
 
 	var candidates [][]string
@@ -192,18 +309,22 @@ func (h *Hand) FigureStraightCandidate(tile string) [][]string {
 }
 
 func (h *Hand) FigureActions() []*Action {
+	return h.FigureActionsWithTileSet(StandardSetOfTiles)
+}
+
+// FigureActionsWithTileSet figures out actions after drawing a tile, tile set decides how tiles make sets
+func (h *Hand) FigureActionsWithTileSet(tileSetDef *TileSetDef) []*Action {
 
 	var actions []*Action
 
 	// Win by self draw
-	isWin := CheckWinningTiles(h.Tiles, true, SuitedTileRule)
-
-	if isWin {
+	state := Resolve(tileSetDef, h.Tiles)
+	if state.IsWin {
 		actions = append(actions, &Action{Name: "win"})
 	}
 
-	// Concealed kong
-	if CountSpecificTile(h.Tiles, h.Draw[0]) == 4 {
+	// Concealed kong or adding drawn tile to melded pung
+	if CountSpecificTile(h.Tiles, h.Draw[0]) == 4 || ContainsTile(h.Triplet, h.Draw[0]) {
 		actions = append(actions, &Action{Name: "kong"})
 	}
 
@@ -215,6 +336,11 @@ func (h *Hand) FigureActions() []*Action {
 }
 
 func (h *Hand) FigureReactions(tileSetDef *TileSetDef, tile string, relativeSeatIdx int) []*Action {
+	return h.FigureReactionsWithRuleSet(tileSetDef, tile, relativeSeatIdx, TaiwanRuleSet)
+}
+
+// FigureReactionsWithRuleSet figures out reactions to discarded tile, rule set decides who can chow
+func (h *Hand) FigureReactionsWithRuleSet(tileSetDef *TileSetDef, tile string, relativeSeatIdx int, rs *RuleSet) []*Action {
 
 	var actions []*Action
 
@@ -226,17 +352,30 @@ func (h *Hand) FigureReactions(tileSetDef *TileSetDef, tile string, relativeSeat
 		actions = append(actions, &Action{Name: "win"})
 	}
 
+	count := CountSpecificTile(h.Tiles, tile)
+
+	// Wild tiles make up pung or kong
+	wilds := 0
+	if wild := tileSetDef.GetWild(); wild != nil && wild.InClaim && !tileSetDef.IsWildTile(tile) {
+		_, w := SplitWildTiles(tileSetDef, h.Tiles)
+		wilds = len(w)
+	}
+
 	// Kong
-	if CountSpecificTile(h.Tiles, tile) == 3 {
+	if count == 3 || (count < 3 && count+wilds >= 3) {
 		actions = append(actions, &Action{Name: "kong"})
 	}
 
 	// Pung
-	if CountSpecificTile(h.Tiles, tile) == 2 {
+	if count == 2 || (count < 2 && count+wilds >= 2) {
 		actions = append(actions, &Action{Name: "pung"})
 	}
 
-	if relativeSeatIdx == 1 {
+	if rs == nil {
+		rs = TaiwanRuleSet
+	}
+
+	if rs.CanChow(relativeSeatIdx) {
 		// Chow
 		candidates := h.FigureStraightCandidate(tile)
 		if len(candidates) != 0 {
@@ -0,0 +1,41 @@
+package foursquare
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// newTestTable returns table of bots, dices and wall of game are made from seed
+func newTestTable(opts *Options, seed int64) *Table {
+
+	if opts == nil {
+		opts = NewOptions()
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	opts.Dices = RollDicesWithRand(r)
+	opts.Tiles = ShuffleTilesWithRand(NewTileSet(opts.TileSetDef), r)
+
+	table := NewTable(NewGame(opts))
+	for i := 0; i < opts.PlayerCount; i++ {
+		table.Sit(i, NewBot())
+	}
+
+	return table
+}
+
+// stepUntil lets bots play until game is waiting for event
+func stepUntil(t *testing.T, table *Table, ge GameEvent) {
+
+	gs := table.GetGame().GetState()
+	for gs.Status.CurrentEvent != GetGameEventSymbols(ge) {
+
+		if gs.Status.CurrentEvent == GetGameEventSymbols(GameEvent_GameClosed) {
+			t.Fatalf("game was closed before %s", GetGameEventSymbols(ge))
+		}
+
+		if err := table.Step(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
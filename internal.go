@@ -2,11 +2,83 @@ package foursquare
 
 func (g *Game) resetAllowedActions() {
 
-	for _, p := range g.gs.Players {
-		p.ResetAllowedActions()
+	for i := range g.gs.Players {
+		g.gs.Players[i].ResetAllowedActions()
 	}
 }
 
+func (g *Game) getBanker() *PlayerState {
+
+	for i := range g.gs.Players {
+		if g.gs.Players[i].IsBanker {
+			return &g.gs.Players[i]
+		}
+	}
+
+	return nil
+}
+
+func (g *Game) isBankerInvolved(ps *PlayerState, wc *WinContext) bool {
+
+	if ps.IsBanker {
+		return true
+	}
+
+	// Banker dealt in
+	source := g.GetPlayer(wc.SourcePlayer)
+	if source != nil && source.IsBanker && !wc.IsSelfDrawn {
+		return true
+	}
+
+	return false
+}
+
+func (g *Game) getRemainingTileCount() int {
+	return g.gs.GetRemainingTileCount()
+}
+
+func (g *Game) reactToAddedKong(playerIdx int, reaction string) error {
+
+	tile := g.gs.Status.AddedKongTile
+
+	// No one robs the kong
+	if playerIdx == -1 {
+		g.gs.Status.AddedKongTile = ""
+		g.resetAllowedActions()
+		return g.DrawSupplementTile()
+	}
+
+	ps := g.GetPlayer(playerIdx)
+	if ps == nil {
+		return ErrInvalidPlayer
+	}
+
+	if reaction != "win" || !ps.IsAllowedAction(reaction) {
+		return ErrInvalidReaction
+	}
+
+	kongPlayer := g.gs.Status.CurrentPlayer
+
+	// Tile of kong was robbed, it is a melded pung again
+	if err := g.GetPlayer(kongPlayer).Hand.undoAddedKong(tile); err != nil {
+		return err
+	}
+
+	g.gs.Status.AddedKongTile = ""
+	g.gs.Status.AfterKong = false
+	g.gs.Status.CurrentPlayer = playerIdx
+	g.resetAllowedActions()
+
+	payload := &GameEventPayload_Win{
+		DiscardingPlayer: kongPlayer,
+		WinningTile:      tile,
+		Winners:          []int{playerIdx},
+		IsRobbedKong:     true,
+	}
+
+	return g.triggerEvent(GameEvent_Win, payload)
+}
+
 func (g *Game) getPlayersStartingFrom(idx int) []*PlayerState {
 
 	var players []*PlayerState
@@ -30,27 +102,16 @@ func (g *Game) getPlayersStartingFrom(idx int) []*PlayerState {
 	return players
 }
 
-func (g *Game) drawTile() (string, []string) {
-
-	var tile string
-	var flowerTiles []string
-
-	tile = g.gs.Meta.Tiles[g.gs.Status.CurrentTileSetPosition]
-	g.gs.Status.CurrentTileSetPosition++
+func (g *Game) dealTiles(count int) []string {
 
-	if TileSuit(tile[0:1]) == TileSuitFlower {
-		flowerTiles = append(flowerTiles, tile)
+	// No more tiles than the wall has
+	if remaining := g.getRemainingTileCount(); count > remaining {
+		count = remaining
 	}
 
-	t, fts := g.drawSupplementTile()
-
-	tile = t
-	flowerTiles = append(flowerTiles, fts...)
-
-	return tile, flowerTiles
-}
-
-func (g *Game) dealTiles(count int) []string {
+	if count < 0 {
+		count = 0
+	}
 
 	tiles := make([]string, 0, count)
 
@@ -73,7 +134,7 @@ func (g *Game) drawSupplementTile() (string, []string) {
 		t := g.gs.Meta.Tiles[g.gs.Status.CurrentSupplementPosition]
 
 		// Check if it is not flower tile
-		if TileSuit(t[0:1]) != TileSuitFlower {
+		if !g.isBonusTile(t) {
 			tile = t
 			g.gs.Status.CurrentSupplementPosition--
 			break
@@ -119,7 +180,7 @@ func (g *Game) initializeHandTiles() {
 		for _, tile := range ps.Hand.Tiles {
 
 			// Check if it is flower tile
-			if TileSuit(tile[0:1]) == TileSuitFlower {
+			if g.isBonusTile(tile) {
 				ps.Hand.Flowers = append(ps.Hand.Flowers, tile)
 				continue
 			}
@@ -142,12 +203,13 @@ func (g *Game) initializePlayers() {
 	for i := 0; i < g.gs.Meta.PlayerCount; i++ {
 
 		ps := PlayerState{
-			Idx: i,
+			Idx:  i,
+			Wind: FigureSeatWind(i, g.gs.Meta.Banker, g.gs.Meta.PlayerCount),
 		}
 
 		ps.ResetAllowedActions()
 
-		if i == 0 {
+		if i == g.gs.Meta.Banker {
 			ps.IsBanker = true
 		}
 
@@ -178,3 +240,38 @@ func (g *Game) initializePlayers() {
 
 	g.initializeHandTiles()
 }
+
+func (g *Game) getPointCalculator() *PointCalculator {
+
+	rules := g.gs.Meta.PointRules
+	if rules == nil {
+		rules = StandardRules
+	}
+
+	pc := NewPointCalculator(rules)
+	pc.TileSetDef = g.gs.Meta.TileSetDef
+
+	return pc
+}
+
+func (g *Game) getWinningHand(ps *PlayerState, wc *WinContext) *Hand {
+
+	hand := ps.Hand.Clone()
+
+	// Flowers were taken already
+	if wc.IsFlowerWin() {
+		return hand
+	}
+
+	// Winning tile was drawn by player and it is in hand already
+	if wc.IsSelfDrawn {
+		hand.Draw = []string{wc.WinningTile}
+		return hand
+	}
+
+	// Take winning tile from discarding player
+	hand.Tiles = append(hand.Tiles, wc.WinningTile)
+	hand.Draw = []string{}
+
+	return hand
+}
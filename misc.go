@@ -14,3 +14,11 @@ func RollDices() []int {
 		rand.Intn(6) + 1,
 	}
 }
+
+// RollDicesWithRand rolls dices with given source, it makes games reproducible
+func RollDicesWithRand(r *rand.Rand) []int {
+	return []int{
+		r.Intn(6) + 1,
+		r.Intn(6) + 1,
+	}
+}
@@ -0,0 +1,398 @@
+package foursquare
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var (
+	ErrInvalidNotation = errors.New("notation: invalid notation")
+)
+
+// Order of suits in notation
+var notationSuits = []TileSuit{
+	TileSuitWan,
+	TileSuitTong,
+	TileSuitBamboo,
+	TileSuitWind,
+	TileSuitDragon,
+	TileSuitFlower,
+	TileSuitSeason,
+}
+
+// ParseTiles parses compact notation like "123w456t789b11i" into tiles.
+//
+// Letters of suits are w(萬), t(筒), b(條), i(風), d(三元), f(花) and s(季). The common style
+// "123m456p789s11z" is also accepted, in which z stands for honors (1-4 winds, 5-7 dragons)
+// and s stands for bamboo. Notation which uses s only is read as seasons unless numbers are
+// more than 4, for instance "789s".
+func ParseTiles(str string) ([]string, error) {
+	return parseTiles(str, isCommonNotation(str))
+}
+
+// isCommonNotation figures out style of the whole notation
+func isCommonNotation(str string) bool {
+
+	if strings.ContainsAny(str, "mpz") {
+		return true
+	}
+
+	if strings.ContainsAny(str, "wtbidf") {
+		return false
+	}
+
+	// Numbers of seasons are never more than 4
+	return strings.ContainsAny(str, "56789")
+}
+
+func parseTiles(str string, isCommonStyle bool) ([]string, error) {
+
+	tiles := make([]string, 0)
+
+	numbers := make([]int, 0)
+	for _, c := range str {
+
+		if c >= '1' && c <= '9' {
+			numbers = append(numbers, int(c-'0'))
+			continue
+		}
+
+		if len(numbers) == 0 {
+			return nil, ErrInvalidNotation
+		}
+
+		for _, n := range numbers {
+
+			tile, err := parseNotationTile(c, n, isCommonStyle)
+			if err != nil {
+				return nil, err
+			}
+
+			tiles = append(tiles, tile)
+		}
+
+		numbers = numbers[:0]
+	}
+
+	// Numbers without suit
+	if len(numbers) > 0 {
+		return nil, ErrInvalidNotation
+	}
+
+	return tiles, nil
+}
+
+// MustParseTiles is like ParseTiles but panics if notation is invalid, it is useful for fixtures
+func MustParseTiles(str string) []string {
+
+	tiles, err := ParseTiles(str)
+	if err != nil {
+		panic(err)
+	}
+
+	return tiles
+}
+
+func parseNotationTile(c rune, num int, isCommonStyle bool) (string, error) {
+
+	var suit TileSuit
+
+	switch c {
+	case 'w', 'm':
+		suit = TileSuitWan
+	case 't', 'p':
+		suit = TileSuitTong
+	case 'b':
+		suit = TileSuitBamboo
+	case 'i':
+		suit = TileSuitWind
+	case 'd':
+		suit = TileSuitDragon
+	case 'f':
+		suit = TileSuitFlower
+	case 's':
+		suit = TileSuitSeason
+		if isCommonStyle {
+			suit = TileSuitBamboo
+		}
+	case 'z':
+		suit = TileSuitWind
+		if num > 4 {
+			suit = TileSuitDragon
+			num -= 4
+		}
+	default:
+		return "", ErrInvalidNotation
+	}
+
+	if num > getNotationSuitNumbers(suit) {
+		return "", ErrInvalidNotation
+	}
+
+	return fmt.Sprintf("%s%d", suit, num), nil
+}
+
+func getNotationSuitNumbers(suit TileSuit) int {
+
+	switch suit {
+	case TileSuitWind, TileSuitFlower, TileSuitSeason:
+		return 4
+	case TileSuitDragon:
+		return 3
+	}
+
+	return 9
+}
+
+// FormatTiles prints tiles in compact notation, tiles are sorted by suit and number
+func FormatTiles(tiles []string) (string, error) {
+
+	for _, t := range tiles {
+		if !isNotationTile(t) {
+			return "", ErrInvalidNotation
+		}
+	}
+
+	sorted := append([]string{}, tiles...)
+	SortTiles(sorted)
+
+	var sb strings.Builder
+
+	for i, t := range sorted {
+
+		sb.WriteString(t[1:])
+
+		// End of suit
+		if i == len(sorted)-1 || sorted[i+1][0:1] != t[0:1] {
+			sb.WriteString(strings.ToLower(t[0:1]))
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// isNotationTile checks if tile is able to be printed in notation
+func isNotationTile(tile string) bool {
+
+	if len(tile) != 2 {
+		return false
+	}
+
+	suit := TileSuit(tile[0:1])
+	if getNotationSuitOrder(suit) == len(notationSuits) {
+		return false
+	}
+
+	num := int(tile[1] - '0')
+
+	return num >= 1 && num <= getNotationSuitNumbers(suit)
+}
+
+// SortTiles sorts tiles in order of 萬筒條風三元花季
+func SortTiles(tiles []string) {
+	sort.SliceStable(tiles, func(i, j int) bool {
+
+		si := getNotationSuitOrder(TileSuit(tiles[i][0:1]))
+		sj := getNotationSuitOrder(TileSuit(tiles[j][0:1]))
+
+		if si != sj {
+			return si < sj
+		}
+
+		return tiles[i][1:] < tiles[j][1:]
+	})
+}
+
+func getNotationSuitOrder(suit TileSuit) int {
+
+	for i, s := range notationSuits {
+		if s == suit {
+			return i
+		}
+	}
+
+	return len(notationSuits)
+}
+
+// ParseHand parses a whole hand in one line, for instance "123w456t11i [789b] [111d] (2222t) 12f +3w".
+//
+// Tiles in brackets are exposed melds (pung, chow or kong), tiles in parentheses are concealed kong,
+// tiles with plus sign are drawn tiles, flowers and seasons are set aside automatically.
+func ParseHand(str string) (*Hand, error) {
+	return ParseHandWithRuleSet(StandardSetOfTiles, str, TaiwanRuleSet)
+}
+
+// ParseHandWithRuleSet parses a whole hand, bonus tiles of tile set and rule set are set aside
+func ParseHandWithRuleSet(tileSetDef *TileSetDef, str string, rs *RuleSet) (*Hand, error) {
+
+	h := NewHand()
+
+	// Style of notation is the same for all tokens
+	isCommonStyle := isCommonNotation(str)
+
+	for _, token := range strings.Fields(str) {
+
+		switch {
+		case strings.HasPrefix(token, "[") && strings.HasSuffix(token, "]"):
+
+			tiles, err := parseTiles(token[1:len(token)-1], isCommonStyle)
+			if err != nil {
+				return nil, err
+			}
+
+			err = addNotationMeld(h, tiles)
+			if err != nil {
+				return nil, err
+			}
+
+		case strings.HasPrefix(token, "(") && strings.HasSuffix(token, ")"):
+
+			tiles, err := parseTiles(token[1:len(token)-1], isCommonStyle)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(tiles) != 4 || CountSpecificTile(tiles, tiles[0]) != 4 {
+				return nil, ErrInvalidNotation
+			}
+
+			h.Kong.Concealed = append(h.Kong.Concealed, tiles[0])
+
+		case strings.HasPrefix(token, "+"):
+
+			tiles, err := parseTiles(token[1:], isCommonStyle)
+			if err != nil {
+				return nil, err
+			}
+
+			h.Deal(tiles)
+
+		default:
+
+			tiles, err := parseTiles(token, isCommonStyle)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, t := range tiles {
+				if rs.IsBonusTile(tileSetDef, t) {
+					h.Flowers = append(h.Flowers, t)
+					continue
+				}
+
+				h.Tiles = append(h.Tiles, t)
+			}
+		}
+	}
+
+	return h, nil
+}
+
+// MustParseHand is like ParseHand but panics if notation is invalid, it is useful for fixtures
+func MustParseHand(str string) *Hand {
+
+	h, err := ParseHand(str)
+	if err != nil {
+		panic(err)
+	}
+
+	return h
+}
+
+func addNotationMeld(h *Hand, tiles []string) error {
+
+	if len(tiles) == 0 {
+		return ErrInvalidNotation
+	}
+
+	same := CountSpecificTile(tiles, tiles[0]) == len(tiles)
+
+	switch {
+	case len(tiles) == 4 && same:
+		h.Kong.Open = append(h.Kong.Open, tiles[0])
+	case len(tiles) == 3 && same:
+		h.Triplet = append(h.Triplet, tiles[0])
+	case len(tiles) == 3 && IsStraight(tiles):
+		h.Straight = append(h.Straight, tiles)
+	default:
+		return ErrInvalidNotation
+	}
+
+	return nil
+}
+
+// IsStraight checks if tiles are three suited tiles in a row
+func IsStraight(tiles []string) bool {
+
+	if len(tiles) != 3 {
+		return false
+	}
+
+	sorted := append([]string{}, tiles...)
+	SortTiles(sorted)
+
+	suit := TileSuit(sorted[0][0:1])
+	if suit != TileSuitWan && suit != TileSuitTong && suit != TileSuitBamboo {
+		return false
+	}
+
+	_, n := RemoveTiles(sorted, MakeStraight(sorted[0]))
+
+	return n == 3
+}
+
+// FormatHand prints hand in the notation which ParseHand accepts
+func FormatHand(h *Hand) (string, error) {
+
+	parts := make([]string, 0)
+
+	var err error
+	format := func(prefix string, tiles []string, suffix string) {
+
+		str, e := FormatTiles(tiles)
+		if e != nil {
+			err = e
+			return
+		}
+
+		parts = append(parts, prefix+str+suffix)
+	}
+
+	// Drawn tile is printed separately
+	tiles, _ := RemoveTiles(h.Tiles, h.Draw)
+	if len(tiles) > 0 {
+		format("", tiles, "")
+	}
+
+	for _, s := range h.Straight {
+		format("[", s, "]")
+	}
+
+	for _, t := range h.Triplet {
+		format("[", []string{t, t, t}, "]")
+	}
+
+	for _, t := range h.Kong.Open {
+		format("[", []string{t, t, t, t}, "]")
+	}
+
+	for _, t := range h.Kong.Concealed {
+		format("(", []string{t, t, t, t}, ")")
+	}
+
+	if len(h.Flowers) > 0 {
+		format("", h.Flowers, "")
+	}
+
+	if len(h.Draw) > 0 {
+		format("+", h.Draw, "")
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(parts, " "), nil
+}
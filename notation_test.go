@@ -0,0 +1,97 @@
+package foursquare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Notation_ParseTiles(t *testing.T) {
+
+	cases := []struct {
+		Notation string
+		Tiles    []string
+	}{
+		{"123w456t789b11i", []string{"W1", "W2", "W3", "T4", "T5", "T6", "B7", "B8", "B9", "I1", "I1"}},
+		{"123m456p789s1155z", []string{"W1", "W2", "W3", "T4", "T5", "T6", "B7", "B8", "B9", "I1", "I1", "D1", "D1"}},
+		{"123d14f2s", []string{"D1", "D2", "D3", "F1", "F4", "S2"}},
+		{"789s", []string{"B7", "B8", "B9"}},
+		{"1234s", []string{"S1", "S2", "S3", "S4"}},
+		{"", []string{}},
+	}
+
+	for i, c := range cases {
+		tiles, err := ParseTiles(c.Notation)
+		assert.Nil(t, err, i)
+		assert.Equal(t, c.Tiles, tiles, i)
+	}
+
+	for _, n := range []string{"123", "w", "5i", "4d", "123x", "8z"} {
+		_, err := ParseTiles(n)
+		assert.Equal(t, ErrInvalidNotation, err, n)
+	}
+}
+
+func Test_Notation_FormatTiles(t *testing.T) {
+
+	str, err := FormatTiles([]string{"I1", "B7", "W1", "T4", "B8", "W3", "T5", "W2", "B9", "I1", "T6"})
+	assert.Nil(t, err)
+	assert.Equal(t, "123w456t789b11i", str)
+
+	str, err = FormatTiles([]string{})
+	assert.Nil(t, err)
+	assert.Equal(t, "", str)
+
+	// Tiles which don't exist
+	for _, tile := range []string{"D5", "I0", "X1", "W10", HiddenTile} {
+		_, err = FormatTiles([]string{"W1", tile})
+		assert.Equal(t, ErrInvalidNotation, err, tile)
+	}
+}
+
+func Test_Notation_ParseHand(t *testing.T) {
+
+	h, err := ParseHand("123w456t11i [789b] [555t] [1111d] (2222t) 12f3s +3w")
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"W1", "W2", "W3", "T4", "T5", "T6", "I1", "I1", "W3"}, h.Tiles)
+	assert.Equal(t, [][]string{{"B7", "B8", "B9"}}, h.Straight)
+	assert.Equal(t, []string{"T5"}, h.Triplet)
+	assert.Equal(t, []string{"D1"}, h.Kong.Open)
+	assert.Equal(t, []string{"T2"}, h.Kong.Concealed)
+	assert.Equal(t, []string{"F1", "F2", "S3"}, h.Flowers)
+	assert.Equal(t, []string{"W3"}, h.Draw)
+
+	// Round trip
+	str, err := FormatHand(MustParseHand("456t123w11i [789b] [555t] [1111d] (2222t) 12f3s +3w"))
+	assert.Nil(t, err)
+	assert.Equal(t, "123w456t11i [789b] [555t] [1111d] (2222t) 12f3s +3w", str)
+
+	// Style is figured out for the whole hand
+	h, err = ParseHand("123m456p11z [789s]")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"W1", "W2", "W3", "T4", "T5", "T6", "I1", "I1"}, h.Tiles)
+	assert.Equal(t, [][]string{{"B7", "B8", "B9"}}, h.Straight)
+
+	_, err = FormatHand(&Hand{Tiles: []string{"D5"}})
+	assert.Equal(t, ErrInvalidNotation, err)
+
+	for _, n := range []string{"[12w]", "[124w]", "(222t)", "[123i]"} {
+		_, err := ParseHand(n)
+		assert.Equal(t, ErrInvalidNotation, err, n)
+	}
+}
+
+func Test_Notation_ParseHandWithRuleSet(t *testing.T) {
+
+	// North wind is a bonus tile of three-player rule set
+	h, err := ParseHandWithRuleSet(ThreePlayerSetOfTiles, "123w14i 1f", ThreePlayerRuleSet)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"W1", "W2", "W3", "I1"}, h.Tiles)
+	assert.Equal(t, []string{"I4", "F1"}, h.Flowers)
+
+	h, err = ParseHand("123w14i 1f")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"W1", "W2", "W3", "I1", "I4"}, h.Tiles)
+	assert.Equal(t, []string{"F1"}, h.Flowers)
+}
@@ -5,20 +5,56 @@ type Options struct {
 	HandTileCount int         `json:"handtile_count"`
 	PlayerCount   int         `json:"player_count"`
 	WinningStreak int         `json:"winning_streak"`
+	BasePoint     int         `json:"base_point"`  // 底
+	PointValue    int         `json:"point_value"` // 台
 	Dices         []int       `json:"dices"`
 	Tiles         []string    `json:"tiles"`
 
+	Banker            int    `json:"banker"`
+	PrevailingWind    string `json:"prevailing_wind"` // 圈風
+	BankerStaysOnWin  bool   `json:"banker_stays_on_win"`
+	BankerStaysOnDraw bool   `json:"banker_stays_on_draw"`
+
+	PointRules  map[PointType]PointRule `json:"point_rules,omitempty"`
+	FlowerRules FlowerRules             `json:"flower_rules"`
+
+	RuleSet     *RuleSet      `json:"ruleset,omitempty"` // Claims, bonus tiles and payment
 	InitialHand map[int]*Hand `json:"initial_hand,omitempty"`
+
+	// Game state is saved to store after each command if it is set
+	Store GameStore `json:"-"`
 }
 
 func NewOptions() *Options {
-	return &Options{
-		TileSetDef:    StandardSetOfTiles,
-		HandTileCount: 16,
-		PlayerCount:   4,
-		WinningStreak: 0,
-		Dices:         make([]int, 0),
-		Tiles:         make([]string, 0),
-		InitialHand:   nil,
+	return NewOptionsWithRuleSet(TaiwanRuleSet)
+}
+
+// NewOptionsWithRuleSet returns options which preset rule set is played with, fields of options still can be overridden.
+// Rule set which is not a preset is played with options of Taiwan rule set.
+func NewOptionsWithRuleSet(rs *RuleSet) *Options {
+
+	opts := &Options{
+		TileSetDef:        StandardSetOfTiles,
+		HandTileCount:     16,
+		PlayerCount:       4,
+		WinningStreak:     0,
+		BasePoint:         100,
+		PointValue:        20,
+		Dices:             make([]int, 0),
+		Tiles:             make([]string, 0),
+		Banker:            0,
+		PrevailingWind:    "I1",
+		BankerStaysOnWin:  true,
+		BankerStaysOnDraw: true,
+		PointRules:        StandardRules,
+		FlowerRules:       FlowerRules{},
+		RuleSet:           rs,
+		InitialHand:       nil,
+	}
+
+	if apply, ok := presetOptions[rs]; ok {
+		apply(opts)
 	}
+
+	return opts
 }
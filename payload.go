@@ -4,4 +4,13 @@ type GameEventPayload_Win struct {
 	DiscardingPlayer int    `json:"discarding_player"`
 	WinningTile      string `json:"winning_tile"`
 	Winners          []int  `json:"winners"`
+	IsRobbedKong     bool   `json:"is_robbed_kong,omitempty"`
+	IsEightImmortals bool   `json:"is_eight_immortals,omitempty"`
+	IsSevenRobOne    bool   `json:"is_seven_rob_one,omitempty"`
+}
+
+type GameEventPayload_FlowerKong struct {
+	Player  int      `json:"player"`
+	Tiles   []string `json:"tiles"`              // Set of flowers
+	IsDealt bool     `json:"is_dealt,omitempty"` // Player was dealt the flowers
 }
@@ -0,0 +1,701 @@
+package pb
+
+import (
+	"github.com/weedbox/foursquare"
+)
+
+// FromGameState converts game state of engine to protobuf message
+func FromGameState(gs *foursquare.GameState) *GameState {
+
+	if gs == nil {
+		return nil
+	}
+
+	s := &GameState{
+		GameId:    gs.GameID,
+		CreatedAt: gs.CreatedAt,
+		UpdatedAt: gs.UpdatedAt,
+		Revision:  gs.Revision,
+		Meta:      FromMeta(&gs.Meta),
+		Players:   make([]*PlayerState, 0, len(gs.Players)),
+		Status:    FromStatus(&gs.Status),
+		Result:    FromResult(gs.Result),
+	}
+
+	for i := range gs.Players {
+		s.Players = append(s.Players, FromPlayerState(&gs.Players[i]))
+	}
+
+	for _, a := range gs.Actions {
+		s.Actions = append(s.Actions, FromRecordAction(a))
+	}
+
+	return s
+}
+
+// ToGameState converts protobuf message to game state of engine
+func ToGameState(s *GameState) *foursquare.GameState {
+
+	if s == nil {
+		return nil
+	}
+
+	gs := &foursquare.GameState{
+		GameID:    s.GameId,
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.UpdatedAt,
+		Revision:  s.Revision,
+		Players:   make([]foursquare.PlayerState, 0, len(s.Players)),
+		Result:    ToResult(s.Result),
+	}
+
+	if s.Meta != nil {
+		gs.Meta = *ToMeta(s.Meta)
+	}
+
+	if s.Status != nil {
+		gs.Status = *ToStatus(s.Status)
+	}
+
+	for _, ps := range s.Players {
+		gs.Players = append(gs.Players, *ToPlayerState(ps))
+	}
+
+	for _, a := range s.Actions {
+		gs.Actions = append(gs.Actions, ToRecordAction(a))
+	}
+
+	return gs
+}
+
+func FromRecordAction(a foursquare.RecordAction) *RecordAction {
+	return &RecordAction{
+		Type:   a.Type,
+		Player: int32(a.Player),
+		Action: a.Action,
+		Tile:   a.Tile,
+		Tiles:  fromStrings(a.Tiles),
+	}
+}
+
+func ToRecordAction(s *RecordAction) foursquare.RecordAction {
+
+	a := foursquare.RecordAction{
+		Type:   s.Type,
+		Player: int(s.Player),
+		Action: s.Action,
+		Tile:   s.Tile,
+	}
+
+	if len(s.Tiles) > 0 {
+		a.Tiles = toStrings(s.Tiles)
+	}
+
+	return a
+}
+
+func FromMeta(m *foursquare.Meta) *Meta {
+	return &Meta{
+		TilesetDef:        FromTileSetDef(m.TileSetDef),
+		HandtileCount:     int32(m.HandTileCount),
+		PlayerCount:       int32(m.PlayerCount),
+		WinningStreak:     int32(m.WinningStreak),
+		BasePoint:         int32(m.BasePoint),
+		PointValue:        int32(m.PointValue),
+		Dices:             fromInts(m.Dices),
+		Tiles:             fromStrings(m.Tiles),
+		Banker:            int32(m.Banker),
+		PrevailingWind:    m.PrevailingWind,
+		BankerStaysOnWin:  m.BankerStaysOnWin,
+		BankerStaysOnDraw: m.BankerStaysOnDraw,
+		PointRules:        fromPointRules(m.PointRules),
+		FlowerRules:       FromFlowerRules(&m.FlowerRules),
+		Ruleset:           FromRuleSet(m.RuleSet),
+	}
+}
+
+func ToMeta(m *Meta) *foursquare.Meta {
+	return &foursquare.Meta{
+		TileSetDef:        ToTileSetDef(m.TilesetDef),
+		HandTileCount:     int(m.HandtileCount),
+		PlayerCount:       int(m.PlayerCount),
+		WinningStreak:     int(m.WinningStreak),
+		BasePoint:         int(m.BasePoint),
+		PointValue:        int(m.PointValue),
+		Dices:             toInts(m.Dices),
+		Tiles:             toStrings(m.Tiles),
+		Banker:            int(m.Banker),
+		PrevailingWind:    m.PrevailingWind,
+		BankerStaysOnWin:  m.BankerStaysOnWin,
+		BankerStaysOnDraw: m.BankerStaysOnDraw,
+		PointRules:        toPointRules(m.PointRules),
+		FlowerRules:       ToFlowerRules(m.FlowerRules),
+		RuleSet:           ToRuleSet(m.Ruleset),
+	}
+}
+
+func FromRuleSet(rs *foursquare.RuleSet) *RuleSet {
+
+	if rs == nil {
+		return nil
+	}
+
+	s := &RuleSet{
+		Name:         rs.Name,
+		ChowFrom:     fromInts(rs.ChowFrom),
+		BonusSuits:   make([]string, 0, len(rs.BonusSuits)),
+		BonusTiles:   fromStrings(rs.BonusTiles),
+		PaymentSeats: int32(rs.PaymentSeats),
+	}
+
+	for _, suit := range rs.BonusSuits {
+		s.BonusSuits = append(s.BonusSuits, string(suit))
+	}
+
+	return s
+}
+
+func ToRuleSet(s *RuleSet) *foursquare.RuleSet {
+
+	if s == nil {
+		return nil
+	}
+
+	rs := &foursquare.RuleSet{
+		Name:         s.Name,
+		ChowFrom:     toInts(s.ChowFrom),
+		BonusSuits:   make([]foursquare.TileSuit, 0, len(s.BonusSuits)),
+		PaymentSeats: int(s.PaymentSeats),
+	}
+
+	if len(s.BonusTiles) > 0 {
+		rs.BonusTiles = toStrings(s.BonusTiles)
+	}
+
+	for _, suit := range s.BonusSuits {
+		rs.BonusSuits = append(rs.BonusSuits, foursquare.TileSuit(suit))
+	}
+
+	return rs
+}
+
+func FromTileSetDef(def *foursquare.TileSetDef) *TileSetDef {
+
+	if def == nil {
+		return nil
+	}
+
+	s := &TileSetDef{
+		Suits: make([]*TileDef, 0, len(def.Suits)),
+	}
+
+	for _, td := range def.Suits {
+		s.Suits = append(s.Suits, fromTileDef(td))
+	}
+
+	if def.Wild != nil {
+		s.Wild = &WildDef{
+			Tiles:   fromStrings(def.Wild.Tiles),
+			InEyes:  def.Wild.InEyes,
+			InChow:  def.Wild.InChow,
+			InClaim: def.Wild.InClaim,
+		}
+	}
+
+	return s
+}
+
+func ToTileSetDef(def *TileSetDef) *foursquare.TileSetDef {
+
+	if def == nil {
+		return nil
+	}
+
+	s := &foursquare.TileSetDef{
+		Suits: make([]foursquare.TileDef, 0, len(def.Suits)),
+	}
+
+	for _, td := range def.Suits {
+		s.Suits = append(s.Suits, toTileDef(td))
+	}
+
+	if def.Wild != nil {
+		s.Wild = &foursquare.WildDef{
+			Tiles:   toStrings(def.Wild.Tiles),
+			InEyes:  def.Wild.InEyes,
+			InChow:  def.Wild.InChow,
+			InClaim: def.Wild.InClaim,
+		}
+	}
+
+	return s
+}
+
+func fromTileDef(td foursquare.TileDef) *TileDef {
+	return &TileDef{
+		Suit:     string(td.Suit),
+		Kind:     string(td.Kind),
+		Numbers:  int32(td.Numbers),
+		Count:    int32(td.Count),
+		Excludes: fromInts(td.Excludes),
+	}
+}
+
+func toTileDef(td *TileDef) foursquare.TileDef {
+
+	if td == nil {
+		return foursquare.TileDef{}
+	}
+
+	def := foursquare.TileDef{
+		Suit:    foursquare.TileSuit(td.Suit),
+		Kind:    foursquare.TileKind(td.Kind),
+		Numbers: int(td.Numbers),
+		Count:   int(td.Count),
+	}
+
+	if len(td.Excludes) > 0 {
+		def.Excludes = toInts(td.Excludes)
+	}
+
+	return def
+}
+
+func fromPointRules(rules map[foursquare.PointType]foursquare.PointRule) map[int32]*PointRule {
+
+	if rules == nil {
+		return nil
+	}
+
+	m := make(map[int32]*PointRule, len(rules))
+	for pt, r := range rules {
+
+		rule := &PointRule{
+			Type:  int32(r.Type),
+			Point: int32(r.Point),
+		}
+
+		for _, t := range r.Implies {
+			rule.Implies = append(rule.Implies, int32(t))
+		}
+
+		for _, t := range r.Excludes {
+			rule.Excludes = append(rule.Excludes, int32(t))
+		}
+
+		m[int32(pt)] = rule
+	}
+
+	return m
+}
+
+func toPointRules(rules map[int32]*PointRule) map[foursquare.PointType]foursquare.PointRule {
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	m := make(map[foursquare.PointType]foursquare.PointRule, len(rules))
+	for pt, r := range rules {
+
+		rule := foursquare.PointRule{
+			Type:  foursquare.PointType(r.Type),
+			Point: int(r.Point),
+		}
+
+		for _, t := range r.Implies {
+			rule.Implies = append(rule.Implies, foursquare.PointType(t))
+		}
+
+		for _, t := range r.Excludes {
+			rule.Excludes = append(rule.Excludes, foursquare.PointType(t))
+		}
+
+		m[foursquare.PointType(pt)] = rule
+	}
+
+	return m
+}
+
+func FromFlowerRules(fr *foursquare.FlowerRules) *FlowerRules {
+	return &FlowerRules{
+		SeatFlower:     fr.SeatFlower,
+		FlowerKong:     fr.FlowerKong,
+		EightImmortals: fr.EightImmortals,
+		SevenRobOne:    fr.SevenRobOne,
+	}
+}
+
+func ToFlowerRules(fr *FlowerRules) foursquare.FlowerRules {
+
+	if fr == nil {
+		return foursquare.FlowerRules{}
+	}
+
+	return foursquare.FlowerRules{
+		SeatFlower:     fr.SeatFlower,
+		FlowerKong:     fr.FlowerKong,
+		EightImmortals: fr.EightImmortals,
+		SevenRobOne:    fr.SevenRobOne,
+	}
+}
+
+func FromPlayerState(ps *foursquare.PlayerState) *PlayerState {
+
+	s := &PlayerState{
+		Idx:            int32(ps.Idx),
+		IsBanker:       ps.IsBanker,
+		Wind:           ps.Wind,
+		IsReadyHand:    ps.IsReadyHand,
+		Hand:           FromHand(ps.Hand),
+		AllowedActions: make([]*Action, 0, len(ps.AllowedActions)),
+	}
+
+	for _, a := range ps.AllowedActions {
+		s.AllowedActions = append(s.AllowedActions, FromAction(a))
+	}
+
+	return s
+}
+
+func ToPlayerState(s *PlayerState) *foursquare.PlayerState {
+
+	ps := &foursquare.PlayerState{
+		Idx:            int(s.Idx),
+		IsBanker:       s.IsBanker,
+		Wind:           s.Wind,
+		IsReadyHand:    s.IsReadyHand,
+		Hand:           ToHand(s.Hand),
+		AllowedActions: make([]*foursquare.Action, 0, len(s.AllowedActions)),
+	}
+
+	for _, a := range s.AllowedActions {
+		ps.AllowedActions = append(ps.AllowedActions, ToAction(a))
+	}
+
+	return ps
+}
+
+func FromHand(h *foursquare.Hand) *Hand {
+
+	if h == nil {
+		return nil
+	}
+
+	return &Hand{
+		Flowers:  fromStrings(h.Flowers),
+		Triplets: fromStrings(h.Triplet),
+		Straight: fromTileGroups(h.Straight),
+		Kong: &HandKong{
+			Open:      fromStrings(h.Kong.Open),
+			Concealed: fromStrings(h.Kong.Concealed),
+		},
+		Tiles: fromStrings(h.Tiles),
+		Draw:  fromStrings(h.Draw),
+		Wilds: fromStrings(h.Wilds),
+	}
+}
+
+func ToHand(s *Hand) *foursquare.Hand {
+
+	if s == nil {
+		return nil
+	}
+
+	h := foursquare.NewHand()
+	h.Flowers = toStrings(s.Flowers)
+	h.Triplet = toStrings(s.Triplets)
+	h.Straight = toTileGroups(s.Straight)
+	h.Tiles = toStrings(s.Tiles)
+	h.Draw = toStrings(s.Draw)
+
+	if len(s.Wilds) > 0 {
+		h.Wilds = toStrings(s.Wilds)
+	}
+
+	if s.Kong != nil {
+		h.Kong.Open = toStrings(s.Kong.Open)
+		h.Kong.Concealed = toStrings(s.Kong.Concealed)
+	}
+
+	return h
+}
+
+func FromAction(a *foursquare.Action) *Action {
+
+	s := &Action{
+		Name:       a.Name,
+		Candidates: fromTileGroups(a.Candidates),
+	}
+
+	for _, c := range a.ReadyHandCandidates {
+		s.ReadyHandCandidates = append(s.ReadyHandCandidates, &DiscardCandidate{
+			DiscardedTile: c.DiscardedTile,
+			TargetTiles:   fromStrings(c.TargetTiles),
+		})
+	}
+
+	return s
+}
+
+func ToAction(s *Action) *foursquare.Action {
+
+	a := &foursquare.Action{
+		Name: s.Name,
+	}
+
+	// Candidates are omitted if there is nothing
+	if len(s.Candidates) > 0 {
+		a.Candidates = toTileGroups(s.Candidates)
+	}
+
+	for _, c := range s.ReadyHandCandidates {
+		a.ReadyHandCandidates = append(a.ReadyHandCandidates, &foursquare.DiscardCandidate{
+			DiscardedTile: c.DiscardedTile,
+			TargetTiles:   toStrings(c.TargetTiles),
+		})
+	}
+
+	return a
+}
+
+func FromStatus(st *foursquare.Status) *Status {
+	return &Status{
+		CurEvent:      st.CurrentEvent,
+		CurTpos:       int32(st.CurrentTileSetPosition),
+		CurSpos:       int32(st.CurrentSupplementPosition),
+		CurPlayer:     int32(st.CurrentPlayer),
+		DiscardArea:   fromStrings(st.DiscardArea),
+		Turn:          int32(st.Turn),
+		AfterKong:     st.AfterKong,
+		AddedKongTile: st.AddedKongTile,
+	}
+}
+
+func ToStatus(s *Status) *foursquare.Status {
+	return &foursquare.Status{
+		CurrentEvent:              s.CurEvent,
+		CurrentTileSetPosition:    int(s.CurTpos),
+		CurrentSupplementPosition: int(s.CurSpos),
+		CurrentPlayer:             int(s.CurPlayer),
+		DiscardArea:               toStrings(s.DiscardArea),
+		Turn:                      int(s.Turn),
+		AfterKong:                 s.AfterKong,
+		AddedKongTile:             s.AddedKongTile,
+	}
+}
+
+func FromResult(r *foursquare.Result) *Result {
+
+	if r == nil {
+		return nil
+	}
+
+	s := &Result{
+		IsDrawnGame:       r.IsDrawnGame,
+		DiscardingPlayer:  int32(r.DiscardingPlayer),
+		WinningTile:       r.WinningTile,
+		NextWinningStreak: int32(r.NextWinningStreak),
+	}
+
+	if r.Winners != nil {
+		s.Winners = make(map[int32]*WinnerResult, len(r.Winners))
+		for idx, w := range r.Winners {
+			s.Winners[int32(idx)] = &WinnerResult{
+				Points:     int32(w.Points),
+				Conditions: fromConditions(w.Conditions),
+				Context:    FromWinContext(w.Context),
+			}
+		}
+	}
+
+	for _, p := range r.Payments {
+		s.Payments = append(s.Payments, &Payment{
+			From:   int32(p.From),
+			To:     int32(p.To),
+			Points: int32(p.Points),
+			Amount: int32(p.Amount),
+		})
+	}
+
+	if r.Deltas != nil {
+		s.Deltas = make(map[int32]int32, len(r.Deltas))
+		for idx, d := range r.Deltas {
+			s.Deltas[int32(idx)] = int32(d)
+		}
+	}
+
+	return s
+}
+
+func ToResult(s *Result) *foursquare.Result {
+
+	if s == nil {
+		return nil
+	}
+
+	r := &foursquare.Result{
+		IsDrawnGame:       s.IsDrawnGame,
+		DiscardingPlayer:  int(s.DiscardingPlayer),
+		WinningTile:       s.WinningTile,
+		NextWinningStreak: int(s.NextWinningStreak),
+	}
+
+	if len(s.Winners) > 0 {
+		r.Winners = make(map[int]foursquare.WinnerResult, len(s.Winners))
+		for idx, w := range s.Winners {
+			r.Winners[int(idx)] = foursquare.WinnerResult{
+				Points:     int(w.Points),
+				Conditions: toConditions(w.Conditions),
+				Context:    ToWinContext(w.Context),
+			}
+		}
+	}
+
+	for _, p := range s.Payments {
+		r.Payments = append(r.Payments, foursquare.Payment{
+			From:   int(p.From),
+			To:     int(p.To),
+			Points: int(p.Points),
+			Amount: int(p.Amount),
+		})
+	}
+
+	if len(s.Deltas) > 0 {
+		r.Deltas = make(map[int]int, len(s.Deltas))
+		for idx, d := range s.Deltas {
+			r.Deltas[int(idx)] = int(d)
+		}
+	}
+
+	return r
+}
+
+func fromConditions(conditions map[foursquare.PointType]int) map[int32]int32 {
+
+	m := make(map[int32]int32, len(conditions))
+	for pt, count := range conditions {
+		m[int32(pt)] = int32(count)
+	}
+
+	return m
+}
+
+func toConditions(conditions map[int32]int32) map[foursquare.PointType]int {
+
+	m := make(map[foursquare.PointType]int, len(conditions))
+	for pt, count := range conditions {
+		m[foursquare.PointType(pt)] = int(count)
+	}
+
+	return m
+}
+
+func FromWinContext(wc *foursquare.WinContext) *WinContext {
+
+	if wc == nil {
+		return nil
+	}
+
+	return &WinContext{
+		Winner:           int32(wc.Winner),
+		WinningTile:      wc.WinningTile,
+		SourcePlayer:     int32(wc.SourcePlayer),
+		IsSelfDrawn:      wc.IsSelfDrawn,
+		IsAfterKong:      wc.IsAfterKong,
+		IsLastTile:       wc.IsLastTile,
+		IsRobbedKong:     wc.IsRobbedKong,
+		IsReadyHand:      wc.IsReadyHand,
+		Turn:             int32(wc.Turn),
+		IsEightImmortals: wc.IsEightImmortals,
+		IsSevenRobOne:    wc.IsSevenRobOne,
+		WildTiles:        fromStrings(wc.WildTiles),
+	}
+}
+
+func ToWinContext(wc *WinContext) *foursquare.WinContext {
+
+	if wc == nil {
+		return nil
+	}
+
+	c := &foursquare.WinContext{
+		Winner:           int(wc.Winner),
+		WinningTile:      wc.WinningTile,
+		SourcePlayer:     int(wc.SourcePlayer),
+		IsSelfDrawn:      wc.IsSelfDrawn,
+		IsAfterKong:      wc.IsAfterKong,
+		IsLastTile:       wc.IsLastTile,
+		IsRobbedKong:     wc.IsRobbedKong,
+		IsReadyHand:      wc.IsReadyHand,
+		Turn:             int(wc.Turn),
+		IsEightImmortals: wc.IsEightImmortals,
+		IsSevenRobOne:    wc.IsSevenRobOne,
+	}
+
+	if len(wc.WildTiles) > 0 {
+		c.WildTiles = toStrings(wc.WildTiles)
+	}
+
+	return c
+}
+
+// GameEventFromSymbol returns event of symbol in Status.CurrentEvent
+func GameEventFromSymbol(symbol string) (GameEvent, bool) {
+
+	v, ok := GameEvent_value[symbol]
+	if !ok {
+		return GameEvent_GameStarted, false
+	}
+
+	return GameEvent(v), true
+}
+
+func fromInts(nums []int) []int32 {
+
+	s := make([]int32, 0, len(nums))
+	for _, n := range nums {
+		s = append(s, int32(n))
+	}
+
+	return s
+}
+
+// Slices of engine are never nil, so that JSON encoding doesn't change after conversion
+func toInts(nums []int32) []int {
+
+	s := make([]int, 0, len(nums))
+	for _, n := range nums {
+		s = append(s, int(n))
+	}
+
+	return s
+}
+
+func fromStrings(strs []string) []string {
+	return append([]string{}, strs...)
+}
+
+func toStrings(strs []string) []string {
+	return append([]string{}, strs...)
+}
+
+func fromTileGroups(groups [][]string) []*Tiles {
+
+	s := make([]*Tiles, 0, len(groups))
+	for _, g := range groups {
+		s = append(s, &Tiles{Tiles: fromStrings(g)})
+	}
+
+	return s
+}
+
+func toTileGroups(groups []*Tiles) [][]string {
+
+	s := make([][]string, 0, len(groups))
+	for _, g := range groups {
+		s = append(s, toStrings(g.Tiles))
+	}
+
+	return s
+}
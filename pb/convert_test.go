@@ -0,0 +1,109 @@
+package pb
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weedbox/foursquare"
+	"google.golang.org/protobuf/proto"
+)
+
+// newTestGameState returns state of a game after banker discarded, with result of a win for converters of result
+func newTestGameState(t *testing.T) *foursquare.GameState {
+
+	opts := foursquare.NewOptions()
+	opts.Dices = []int{3, 4}
+	opts.Tiles = foursquare.NewTileSet(opts.TileSetDef)
+
+	g := foursquare.NewGame(opts)
+	assert.Nil(t, g.StartGame())
+	assert.Nil(t, g.Ready())
+	assert.Nil(t, g.DiscardTile(g.GetCurrentPlayer().Hand.Draw[0]))
+
+	gs := g.GetState()
+	gs.Result = &foursquare.Result{
+		DiscardingPlayer: 2,
+		WinningTile:      "W5",
+		Winners: map[int]foursquare.WinnerResult{
+			1: {
+				Points:     3,
+				Conditions: map[foursquare.PointType]int{foursquare.MinimalPoints: 1, foursquare.SelfDrawn: 1},
+				Context: &foursquare.WinContext{
+					Winner:       1,
+					WinningTile:  "W5",
+					SourcePlayer: 2,
+					IsReadyHand:  true,
+					Turn:         12,
+					WildTiles:    []string{"J1"},
+				},
+			},
+		},
+		Payments: []foursquare.Payment{
+			{From: 2, To: 1, Points: 3, Amount: 160},
+		},
+		Deltas:            map[int]int{1: 160, 2: -160},
+		NextWinningStreak: 0,
+	}
+
+	return gs
+}
+
+func Test_Convert_GameState(t *testing.T) {
+
+	gs := newTestGameState(t)
+	assert.NotEmpty(t, gs.Actions)
+
+	// Through wire format
+	data, err := proto.Marshal(FromGameState(gs))
+	assert.Nil(t, err)
+
+	var s GameState
+	assert.Nil(t, proto.Unmarshal(data, &s))
+
+	expected, _ := json.Marshal(gs)
+	actual, _ := json.Marshal(ToGameState(&s))
+	assert.JSONEq(t, string(expected), string(actual))
+}
+
+func Test_Convert_Hand(t *testing.T) {
+
+	h := foursquare.MustParseHand("123w456t11i [789b] [111d] (2222t) 12f +3w")
+
+	s := FromHand(h)
+	assert.Equal(t, []string{"B7", "B8", "B9"}, s.Straight[0].Tiles)
+	assert.Equal(t, []string{"T2"}, s.Kong.Concealed)
+
+	assert.Equal(t, h, ToHand(s))
+}
+
+func Test_Convert_Action(t *testing.T) {
+
+	a := &foursquare.Action{
+		Name: "readyhand",
+		ReadyHandCandidates: []*foursquare.DiscardCandidate{
+			{DiscardedTile: "W1", TargetTiles: []string{"T2", "T5"}},
+		},
+	}
+
+	assert.Equal(t, a, ToAction(FromAction(a)))
+
+	a = &foursquare.Action{
+		Name:       "chow",
+		Candidates: [][]string{{"W1", "W2"}, {"W2", "W4"}},
+	}
+
+	assert.Equal(t, a, ToAction(FromAction(a)))
+}
+
+func Test_GameEventFromSymbol(t *testing.T) {
+
+	for ge, symbol := range foursquare.GameEventSymbols {
+		e, ok := GameEventFromSymbol(symbol)
+		assert.True(t, ok)
+		assert.Equal(t, int32(ge), int32(e))
+	}
+
+	_, ok := GameEventFromSymbol("Unknown")
+	assert.False(t, ok)
+}
@@ -0,0 +1,3146 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: foursquare.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GameEvent int32
+
+const (
+	GameEvent_GameStarted                GameEvent = 0
+	GameEvent_GameInitialized            GameEvent = 1
+	GameEvent_Ready                      GameEvent = 2
+	GameEvent_PlayerSelected             GameEvent = 3
+	GameEvent_Chow                       GameEvent = 4
+	GameEvent_Pung                       GameEvent = 5
+	GameEvent_Cancel                     GameEvent = 6
+	GameEvent_Kong                       GameEvent = 7
+	GameEvent_ConcealedKong              GameEvent = 8
+	GameEvent_Drawn                      GameEvent = 9
+	GameEvent_FlowerTileDrawn            GameEvent = 10
+	GameEvent_TileDiscarded              GameEvent = 11
+	GameEvent_NoReactions                GameEvent = 12
+	GameEvent_NoMoreTiles                GameEvent = 13
+	GameEvent_GameDrawn                  GameEvent = 14
+	GameEvent_Win                        GameEvent = 15
+	GameEvent_Settlement                 GameEvent = 16
+	GameEvent_GameClosed                 GameEvent = 17
+	GameEvent_WaitForReady               GameEvent = 18
+	GameEvent_WaitForPlayerAction        GameEvent = 19
+	GameEvent_WaitForPlayerToDiscardTile GameEvent = 20
+	GameEvent_WaitForReaction            GameEvent = 21
+	GameEvent_AddedKong                  GameEvent = 22
+	GameEvent_FlowerKong                 GameEvent = 23
+	GameEvent_EightImmortals             GameEvent = 24
+	GameEvent_SevenRobOne                GameEvent = 25
+)
+
+// Enum value maps for GameEvent.
+var (
+	GameEvent_name = map[int32]string{
+		0:  "GameStarted",
+		1:  "GameInitialized",
+		2:  "Ready",
+		3:  "PlayerSelected",
+		4:  "Chow",
+		5:  "Pung",
+		6:  "Cancel",
+		7:  "Kong",
+		8:  "ConcealedKong",
+		9:  "Drawn",
+		10: "FlowerTileDrawn",
+		11: "TileDiscarded",
+		12: "NoReactions",
+		13: "NoMoreTiles",
+		14: "GameDrawn",
+		15: "Win",
+		16: "Settlement",
+		17: "GameClosed",
+		18: "WaitForReady",
+		19: "WaitForPlayerAction",
+		20: "WaitForPlayerToDiscardTile",
+		21: "WaitForReaction",
+		22: "AddedKong",
+		23: "FlowerKong",
+		24: "EightImmortals",
+		25: "SevenRobOne",
+	}
+	GameEvent_value = map[string]int32{
+		"GameStarted":                0,
+		"GameInitialized":            1,
+		"Ready":                      2,
+		"PlayerSelected":             3,
+		"Chow":                       4,
+		"Pung":                       5,
+		"Cancel":                     6,
+		"Kong":                       7,
+		"ConcealedKong":              8,
+		"Drawn":                      9,
+		"FlowerTileDrawn":            10,
+		"TileDiscarded":              11,
+		"NoReactions":                12,
+		"NoMoreTiles":                13,
+		"GameDrawn":                  14,
+		"Win":                        15,
+		"Settlement":                 16,
+		"GameClosed":                 17,
+		"WaitForReady":               18,
+		"WaitForPlayerAction":        19,
+		"WaitForPlayerToDiscardTile": 20,
+		"WaitForReaction":            21,
+		"AddedKong":                  22,
+		"FlowerKong":                 23,
+		"EightImmortals":             24,
+		"SevenRobOne":                25,
+	}
+)
+
+func (x GameEvent) Enum() *GameEvent {
+	p := new(GameEvent)
+	*p = x
+	return p
+}
+
+func (x GameEvent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameEvent) Descriptor() protoreflect.EnumDescriptor {
+	return file_foursquare_proto_enumTypes[0].Descriptor()
+}
+
+func (GameEvent) Type() protoreflect.EnumType {
+	return &file_foursquare_proto_enumTypes[0]
+}
+
+func (x GameEvent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameEvent.Descriptor instead.
+func (GameEvent) EnumDescriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{0}
+}
+
+type TileDef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suit     string  `protobuf:"bytes,1,opt,name=suit,proto3" json:"suit,omitempty"`
+	Numbers  int32   `protobuf:"varint,2,opt,name=numbers,proto3" json:"numbers,omitempty"`
+	Count    int32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Excludes []int32 `protobuf:"varint,4,rep,packed,name=excludes,proto3" json:"excludes,omitempty"`
+	Kind     string  `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+}
+
+func (x *TileDef) Reset() {
+	*x = TileDef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TileDef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TileDef) ProtoMessage() {}
+
+func (x *TileDef) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TileDef.ProtoReflect.Descriptor instead.
+func (*TileDef) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TileDef) GetSuit() string {
+	if x != nil {
+		return x.Suit
+	}
+	return ""
+}
+
+func (x *TileDef) GetNumbers() int32 {
+	if x != nil {
+		return x.Numbers
+	}
+	return 0
+}
+
+func (x *TileDef) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TileDef) GetExcludes() []int32 {
+	if x != nil {
+		return x.Excludes
+	}
+	return nil
+}
+
+func (x *TileDef) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type TileSetDef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Suits []*TileDef `protobuf:"bytes,8,rep,name=suits,proto3" json:"suits,omitempty"`
+	Wild  *WildDef   `protobuf:"bytes,9,opt,name=wild,proto3" json:"wild,omitempty"`
+}
+
+func (x *TileSetDef) Reset() {
+	*x = TileSetDef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TileSetDef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TileSetDef) ProtoMessage() {}
+
+func (x *TileSetDef) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TileSetDef.ProtoReflect.Descriptor instead.
+func (*TileSetDef) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TileSetDef) GetSuits() []*TileDef {
+	if x != nil {
+		return x.Suits
+	}
+	return nil
+}
+
+func (x *TileSetDef) GetWild() *WildDef {
+	if x != nil {
+		return x.Wild
+	}
+	return nil
+}
+
+type WildDef struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tiles   []string `protobuf:"bytes,1,rep,name=tiles,proto3" json:"tiles,omitempty"`
+	InEyes  bool     `protobuf:"varint,2,opt,name=in_eyes,json=inEyes,proto3" json:"in_eyes,omitempty"`
+	InChow  bool     `protobuf:"varint,3,opt,name=in_chow,json=inChow,proto3" json:"in_chow,omitempty"`
+	InClaim bool     `protobuf:"varint,4,opt,name=in_claim,json=inClaim,proto3" json:"in_claim,omitempty"`
+}
+
+func (x *WildDef) Reset() {
+	*x = WildDef{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WildDef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WildDef) ProtoMessage() {}
+
+func (x *WildDef) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WildDef.ProtoReflect.Descriptor instead.
+func (*WildDef) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WildDef) GetTiles() []string {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+func (x *WildDef) GetInEyes() bool {
+	if x != nil {
+		return x.InEyes
+	}
+	return false
+}
+
+func (x *WildDef) GetInChow() bool {
+	if x != nil {
+		return x.InChow
+	}
+	return false
+}
+
+func (x *WildDef) GetInClaim() bool {
+	if x != nil {
+		return x.InClaim
+	}
+	return false
+}
+
+type PointRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     int32   `protobuf:"varint,1,opt,name=type,proto3" json:"type,omitempty"`
+	Point    int32   `protobuf:"varint,2,opt,name=point,proto3" json:"point,omitempty"`
+	Implies  []int32 `protobuf:"varint,3,rep,packed,name=implies,proto3" json:"implies,omitempty"`
+	Excludes []int32 `protobuf:"varint,4,rep,packed,name=excludes,proto3" json:"excludes,omitempty"`
+}
+
+func (x *PointRule) Reset() {
+	*x = PointRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PointRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PointRule) ProtoMessage() {}
+
+func (x *PointRule) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PointRule.ProtoReflect.Descriptor instead.
+func (*PointRule) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PointRule) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *PointRule) GetPoint() int32 {
+	if x != nil {
+		return x.Point
+	}
+	return 0
+}
+
+func (x *PointRule) GetImplies() []int32 {
+	if x != nil {
+		return x.Implies
+	}
+	return nil
+}
+
+func (x *PointRule) GetExcludes() []int32 {
+	if x != nil {
+		return x.Excludes
+	}
+	return nil
+}
+
+type FlowerRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SeatFlower     bool `protobuf:"varint,1,opt,name=seat_flower,json=seatFlower,proto3" json:"seat_flower,omitempty"`
+	FlowerKong     bool `protobuf:"varint,2,opt,name=flower_kong,json=flowerKong,proto3" json:"flower_kong,omitempty"`
+	EightImmortals bool `protobuf:"varint,3,opt,name=eight_immortals,json=eightImmortals,proto3" json:"eight_immortals,omitempty"`
+	SevenRobOne    bool `protobuf:"varint,4,opt,name=seven_rob_one,json=sevenRobOne,proto3" json:"seven_rob_one,omitempty"`
+}
+
+func (x *FlowerRules) Reset() {
+	*x = FlowerRules{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowerRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowerRules) ProtoMessage() {}
+
+func (x *FlowerRules) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlowerRules.ProtoReflect.Descriptor instead.
+func (*FlowerRules) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FlowerRules) GetSeatFlower() bool {
+	if x != nil {
+		return x.SeatFlower
+	}
+	return false
+}
+
+func (x *FlowerRules) GetFlowerKong() bool {
+	if x != nil {
+		return x.FlowerKong
+	}
+	return false
+}
+
+func (x *FlowerRules) GetEightImmortals() bool {
+	if x != nil {
+		return x.EightImmortals
+	}
+	return false
+}
+
+func (x *FlowerRules) GetSevenRobOne() bool {
+	if x != nil {
+		return x.SevenRobOne
+	}
+	return false
+}
+
+type RuleSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ChowFrom     []int32  `protobuf:"varint,5,rep,packed,name=chow_from,json=chowFrom,proto3" json:"chow_from,omitempty"`
+	BonusSuits   []string `protobuf:"bytes,6,rep,name=bonus_suits,json=bonusSuits,proto3" json:"bonus_suits,omitempty"`
+	BonusTiles   []string `protobuf:"bytes,7,rep,name=bonus_tiles,json=bonusTiles,proto3" json:"bonus_tiles,omitempty"`
+	PaymentSeats int32    `protobuf:"varint,8,opt,name=payment_seats,json=paymentSeats,proto3" json:"payment_seats,omitempty"`
+}
+
+func (x *RuleSet) Reset() {
+	*x = RuleSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RuleSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleSet) ProtoMessage() {}
+
+func (x *RuleSet) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleSet.ProtoReflect.Descriptor instead.
+func (*RuleSet) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RuleSet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RuleSet) GetChowFrom() []int32 {
+	if x != nil {
+		return x.ChowFrom
+	}
+	return nil
+}
+
+func (x *RuleSet) GetBonusSuits() []string {
+	if x != nil {
+		return x.BonusSuits
+	}
+	return nil
+}
+
+func (x *RuleSet) GetBonusTiles() []string {
+	if x != nil {
+		return x.BonusTiles
+	}
+	return nil
+}
+
+func (x *RuleSet) GetPaymentSeats() int32 {
+	if x != nil {
+		return x.PaymentSeats
+	}
+	return 0
+}
+
+type Meta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TilesetDef        *TileSetDef          `protobuf:"bytes,1,opt,name=tileset_def,json=tilesetDef,proto3" json:"tileset_def,omitempty"`
+	HandtileCount     int32                `protobuf:"varint,2,opt,name=handtile_count,json=handtileCount,proto3" json:"handtile_count,omitempty"`
+	PlayerCount       int32                `protobuf:"varint,3,opt,name=player_count,json=playerCount,proto3" json:"player_count,omitempty"`
+	WinningStreak     int32                `protobuf:"varint,4,opt,name=winning_streak,json=winningStreak,proto3" json:"winning_streak,omitempty"`
+	BasePoint         int32                `protobuf:"varint,5,opt,name=base_point,json=basePoint,proto3" json:"base_point,omitempty"`
+	PointValue        int32                `protobuf:"varint,6,opt,name=point_value,json=pointValue,proto3" json:"point_value,omitempty"`
+	Dices             []int32              `protobuf:"varint,7,rep,packed,name=dices,proto3" json:"dices,omitempty"`
+	Tiles             []string             `protobuf:"bytes,8,rep,name=tiles,proto3" json:"tiles,omitempty"`
+	Banker            int32                `protobuf:"varint,9,opt,name=banker,proto3" json:"banker,omitempty"`
+	PrevailingWind    string               `protobuf:"bytes,10,opt,name=prevailing_wind,json=prevailingWind,proto3" json:"prevailing_wind,omitempty"`
+	BankerStaysOnWin  bool                 `protobuf:"varint,11,opt,name=banker_stays_on_win,json=bankerStaysOnWin,proto3" json:"banker_stays_on_win,omitempty"`
+	BankerStaysOnDraw bool                 `protobuf:"varint,12,opt,name=banker_stays_on_draw,json=bankerStaysOnDraw,proto3" json:"banker_stays_on_draw,omitempty"`
+	PointRules        map[int32]*PointRule `protobuf:"bytes,13,rep,name=point_rules,json=pointRules,proto3" json:"point_rules,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FlowerRules       *FlowerRules         `protobuf:"bytes,14,opt,name=flower_rules,json=flowerRules,proto3" json:"flower_rules,omitempty"`
+	Ruleset           *RuleSet             `protobuf:"bytes,15,opt,name=ruleset,proto3" json:"ruleset,omitempty"`
+}
+
+func (x *Meta) Reset() {
+	*x = Meta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Meta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Meta) ProtoMessage() {}
+
+func (x *Meta) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Meta.ProtoReflect.Descriptor instead.
+func (*Meta) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Meta) GetTilesetDef() *TileSetDef {
+	if x != nil {
+		return x.TilesetDef
+	}
+	return nil
+}
+
+func (x *Meta) GetHandtileCount() int32 {
+	if x != nil {
+		return x.HandtileCount
+	}
+	return 0
+}
+
+func (x *Meta) GetPlayerCount() int32 {
+	if x != nil {
+		return x.PlayerCount
+	}
+	return 0
+}
+
+func (x *Meta) GetWinningStreak() int32 {
+	if x != nil {
+		return x.WinningStreak
+	}
+	return 0
+}
+
+func (x *Meta) GetBasePoint() int32 {
+	if x != nil {
+		return x.BasePoint
+	}
+	return 0
+}
+
+func (x *Meta) GetPointValue() int32 {
+	if x != nil {
+		return x.PointValue
+	}
+	return 0
+}
+
+func (x *Meta) GetDices() []int32 {
+	if x != nil {
+		return x.Dices
+	}
+	return nil
+}
+
+func (x *Meta) GetTiles() []string {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+func (x *Meta) GetBanker() int32 {
+	if x != nil {
+		return x.Banker
+	}
+	return 0
+}
+
+func (x *Meta) GetPrevailingWind() string {
+	if x != nil {
+		return x.PrevailingWind
+	}
+	return ""
+}
+
+func (x *Meta) GetBankerStaysOnWin() bool {
+	if x != nil {
+		return x.BankerStaysOnWin
+	}
+	return false
+}
+
+func (x *Meta) GetBankerStaysOnDraw() bool {
+	if x != nil {
+		return x.BankerStaysOnDraw
+	}
+	return false
+}
+
+func (x *Meta) GetPointRules() map[int32]*PointRule {
+	if x != nil {
+		return x.PointRules
+	}
+	return nil
+}
+
+func (x *Meta) GetFlowerRules() *FlowerRules {
+	if x != nil {
+		return x.FlowerRules
+	}
+	return nil
+}
+
+func (x *Meta) GetRuleset() *RuleSet {
+	if x != nil {
+		return x.Ruleset
+	}
+	return nil
+}
+
+type HandKong struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Open      []string `protobuf:"bytes,1,rep,name=open,proto3" json:"open,omitempty"`
+	Concealed []string `protobuf:"bytes,2,rep,name=concealed,proto3" json:"concealed,omitempty"`
+}
+
+func (x *HandKong) Reset() {
+	*x = HandKong{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandKong) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandKong) ProtoMessage() {}
+
+func (x *HandKong) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandKong.ProtoReflect.Descriptor instead.
+func (*HandKong) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *HandKong) GetOpen() []string {
+	if x != nil {
+		return x.Open
+	}
+	return nil
+}
+
+func (x *HandKong) GetConcealed() []string {
+	if x != nil {
+		return x.Concealed
+	}
+	return nil
+}
+
+type Tiles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tiles []string `protobuf:"bytes,1,rep,name=tiles,proto3" json:"tiles,omitempty"`
+}
+
+func (x *Tiles) Reset() {
+	*x = Tiles{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tiles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tiles) ProtoMessage() {}
+
+func (x *Tiles) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tiles.ProtoReflect.Descriptor instead.
+func (*Tiles) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Tiles) GetTiles() []string {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+type Hand struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Flowers  []string  `protobuf:"bytes,1,rep,name=flowers,proto3" json:"flowers,omitempty"`
+	Triplets []string  `protobuf:"bytes,2,rep,name=triplets,proto3" json:"triplets,omitempty"`
+	Straight []*Tiles  `protobuf:"bytes,3,rep,name=straight,proto3" json:"straight,omitempty"`
+	Kong     *HandKong `protobuf:"bytes,4,opt,name=kong,proto3" json:"kong,omitempty"`
+	Tiles    []string  `protobuf:"bytes,5,rep,name=tiles,proto3" json:"tiles,omitempty"`
+	Draw     []string  `protobuf:"bytes,6,rep,name=draw,proto3" json:"draw,omitempty"`
+	Wilds    []string  `protobuf:"bytes,7,rep,name=wilds,proto3" json:"wilds,omitempty"`
+}
+
+func (x *Hand) Reset() {
+	*x = Hand{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hand) ProtoMessage() {}
+
+func (x *Hand) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hand.ProtoReflect.Descriptor instead.
+func (*Hand) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Hand) GetFlowers() []string {
+	if x != nil {
+		return x.Flowers
+	}
+	return nil
+}
+
+func (x *Hand) GetTriplets() []string {
+	if x != nil {
+		return x.Triplets
+	}
+	return nil
+}
+
+func (x *Hand) GetStraight() []*Tiles {
+	if x != nil {
+		return x.Straight
+	}
+	return nil
+}
+
+func (x *Hand) GetKong() *HandKong {
+	if x != nil {
+		return x.Kong
+	}
+	return nil
+}
+
+func (x *Hand) GetTiles() []string {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+func (x *Hand) GetDraw() []string {
+	if x != nil {
+		return x.Draw
+	}
+	return nil
+}
+
+func (x *Hand) GetWilds() []string {
+	if x != nil {
+		return x.Wilds
+	}
+	return nil
+}
+
+type DiscardCandidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiscardedTile string   `protobuf:"bytes,1,opt,name=discarded_tile,json=discardedTile,proto3" json:"discarded_tile,omitempty"`
+	TargetTiles   []string `protobuf:"bytes,2,rep,name=target_tiles,json=targetTiles,proto3" json:"target_tiles,omitempty"`
+}
+
+func (x *DiscardCandidate) Reset() {
+	*x = DiscardCandidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscardCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardCandidate) ProtoMessage() {}
+
+func (x *DiscardCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardCandidate.ProtoReflect.Descriptor instead.
+func (*DiscardCandidate) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DiscardCandidate) GetDiscardedTile() string {
+	if x != nil {
+		return x.DiscardedTile
+	}
+	return ""
+}
+
+func (x *DiscardCandidate) GetTargetTiles() []string {
+	if x != nil {
+		return x.TargetTiles
+	}
+	return nil
+}
+
+type Action struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Candidates          []*Tiles            `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	ReadyHandCandidates []*DiscardCandidate `protobuf:"bytes,3,rep,name=ready_hand_candidates,json=readyHandCandidates,proto3" json:"ready_hand_candidates,omitempty"`
+}
+
+func (x *Action) Reset() {
+	*x = Action{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Action) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Action) ProtoMessage() {}
+
+func (x *Action) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Action.ProtoReflect.Descriptor instead.
+func (*Action) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Action) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Action) GetCandidates() []*Tiles {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *Action) GetReadyHandCandidates() []*DiscardCandidate {
+	if x != nil {
+		return x.ReadyHandCandidates
+	}
+	return nil
+}
+
+type PlayerState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Idx            int32     `protobuf:"varint,1,opt,name=idx,proto3" json:"idx,omitempty"`
+	IsBanker       bool      `protobuf:"varint,2,opt,name=is_banker,json=isBanker,proto3" json:"is_banker,omitempty"`
+	Wind           string    `protobuf:"bytes,3,opt,name=wind,proto3" json:"wind,omitempty"`
+	IsReadyHand    bool      `protobuf:"varint,4,opt,name=is_ready_hand,json=isReadyHand,proto3" json:"is_ready_hand,omitempty"`
+	Hand           *Hand     `protobuf:"bytes,5,opt,name=hand,proto3" json:"hand,omitempty"`
+	AllowedActions []*Action `protobuf:"bytes,6,rep,name=allowed_actions,json=allowedActions,proto3" json:"allowed_actions,omitempty"`
+}
+
+func (x *PlayerState) Reset() {
+	*x = PlayerState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerState) ProtoMessage() {}
+
+func (x *PlayerState) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerState.ProtoReflect.Descriptor instead.
+func (*PlayerState) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PlayerState) GetIdx() int32 {
+	if x != nil {
+		return x.Idx
+	}
+	return 0
+}
+
+func (x *PlayerState) GetIsBanker() bool {
+	if x != nil {
+		return x.IsBanker
+	}
+	return false
+}
+
+func (x *PlayerState) GetWind() string {
+	if x != nil {
+		return x.Wind
+	}
+	return ""
+}
+
+func (x *PlayerState) GetIsReadyHand() bool {
+	if x != nil {
+		return x.IsReadyHand
+	}
+	return false
+}
+
+func (x *PlayerState) GetHand() *Hand {
+	if x != nil {
+		return x.Hand
+	}
+	return nil
+}
+
+func (x *PlayerState) GetAllowedActions() []*Action {
+	if x != nil {
+		return x.AllowedActions
+	}
+	return nil
+}
+
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurEvent      string   `protobuf:"bytes,1,opt,name=cur_event,json=curEvent,proto3" json:"cur_event,omitempty"`
+	CurTpos       int32    `protobuf:"varint,2,opt,name=cur_tpos,json=curTpos,proto3" json:"cur_tpos,omitempty"`
+	CurSpos       int32    `protobuf:"varint,3,opt,name=cur_spos,json=curSpos,proto3" json:"cur_spos,omitempty"`
+	CurPlayer     int32    `protobuf:"varint,4,opt,name=cur_player,json=curPlayer,proto3" json:"cur_player,omitempty"`
+	DiscardArea   []string `protobuf:"bytes,5,rep,name=discard_area,json=discardArea,proto3" json:"discard_area,omitempty"`
+	Turn          int32    `protobuf:"varint,6,opt,name=turn,proto3" json:"turn,omitempty"`
+	AfterKong     bool     `protobuf:"varint,7,opt,name=after_kong,json=afterKong,proto3" json:"after_kong,omitempty"`
+	AddedKongTile string   `protobuf:"bytes,8,opt,name=added_kong_tile,json=addedKongTile,proto3" json:"added_kong_tile,omitempty"`
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Status) GetCurEvent() string {
+	if x != nil {
+		return x.CurEvent
+	}
+	return ""
+}
+
+func (x *Status) GetCurTpos() int32 {
+	if x != nil {
+		return x.CurTpos
+	}
+	return 0
+}
+
+func (x *Status) GetCurSpos() int32 {
+	if x != nil {
+		return x.CurSpos
+	}
+	return 0
+}
+
+func (x *Status) GetCurPlayer() int32 {
+	if x != nil {
+		return x.CurPlayer
+	}
+	return 0
+}
+
+func (x *Status) GetDiscardArea() []string {
+	if x != nil {
+		return x.DiscardArea
+	}
+	return nil
+}
+
+func (x *Status) GetTurn() int32 {
+	if x != nil {
+		return x.Turn
+	}
+	return 0
+}
+
+func (x *Status) GetAfterKong() bool {
+	if x != nil {
+		return x.AfterKong
+	}
+	return false
+}
+
+func (x *Status) GetAddedKongTile() string {
+	if x != nil {
+		return x.AddedKongTile
+	}
+	return ""
+}
+
+type WinContext struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Winner           int32    `protobuf:"varint,1,opt,name=winner,proto3" json:"winner,omitempty"`
+	WinningTile      string   `protobuf:"bytes,2,opt,name=winning_tile,json=winningTile,proto3" json:"winning_tile,omitempty"`
+	SourcePlayer     int32    `protobuf:"varint,3,opt,name=source_player,json=sourcePlayer,proto3" json:"source_player,omitempty"`
+	IsSelfDrawn      bool     `protobuf:"varint,4,opt,name=is_self_drawn,json=isSelfDrawn,proto3" json:"is_self_drawn,omitempty"`
+	IsAfterKong      bool     `protobuf:"varint,5,opt,name=is_after_kong,json=isAfterKong,proto3" json:"is_after_kong,omitempty"`
+	IsLastTile       bool     `protobuf:"varint,6,opt,name=is_last_tile,json=isLastTile,proto3" json:"is_last_tile,omitempty"`
+	IsRobbedKong     bool     `protobuf:"varint,7,opt,name=is_robbed_kong,json=isRobbedKong,proto3" json:"is_robbed_kong,omitempty"`
+	IsReadyHand      bool     `protobuf:"varint,8,opt,name=is_ready_hand,json=isReadyHand,proto3" json:"is_ready_hand,omitempty"`
+	Turn             int32    `protobuf:"varint,9,opt,name=turn,proto3" json:"turn,omitempty"`
+	IsEightImmortals bool     `protobuf:"varint,10,opt,name=is_eight_immortals,json=isEightImmortals,proto3" json:"is_eight_immortals,omitempty"`
+	IsSevenRobOne    bool     `protobuf:"varint,11,opt,name=is_seven_rob_one,json=isSevenRobOne,proto3" json:"is_seven_rob_one,omitempty"`
+	WildTiles        []string `protobuf:"bytes,12,rep,name=wild_tiles,json=wildTiles,proto3" json:"wild_tiles,omitempty"`
+}
+
+func (x *WinContext) Reset() {
+	*x = WinContext{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WinContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WinContext) ProtoMessage() {}
+
+func (x *WinContext) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WinContext.ProtoReflect.Descriptor instead.
+func (*WinContext) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *WinContext) GetWinner() int32 {
+	if x != nil {
+		return x.Winner
+	}
+	return 0
+}
+
+func (x *WinContext) GetWinningTile() string {
+	if x != nil {
+		return x.WinningTile
+	}
+	return ""
+}
+
+func (x *WinContext) GetSourcePlayer() int32 {
+	if x != nil {
+		return x.SourcePlayer
+	}
+	return 0
+}
+
+func (x *WinContext) GetIsSelfDrawn() bool {
+	if x != nil {
+		return x.IsSelfDrawn
+	}
+	return false
+}
+
+func (x *WinContext) GetIsAfterKong() bool {
+	if x != nil {
+		return x.IsAfterKong
+	}
+	return false
+}
+
+func (x *WinContext) GetIsLastTile() bool {
+	if x != nil {
+		return x.IsLastTile
+	}
+	return false
+}
+
+func (x *WinContext) GetIsRobbedKong() bool {
+	if x != nil {
+		return x.IsRobbedKong
+	}
+	return false
+}
+
+func (x *WinContext) GetIsReadyHand() bool {
+	if x != nil {
+		return x.IsReadyHand
+	}
+	return false
+}
+
+func (x *WinContext) GetTurn() int32 {
+	if x != nil {
+		return x.Turn
+	}
+	return 0
+}
+
+func (x *WinContext) GetIsEightImmortals() bool {
+	if x != nil {
+		return x.IsEightImmortals
+	}
+	return false
+}
+
+func (x *WinContext) GetIsSevenRobOne() bool {
+	if x != nil {
+		return x.IsSevenRobOne
+	}
+	return false
+}
+
+func (x *WinContext) GetWildTiles() []string {
+	if x != nil {
+		return x.WildTiles
+	}
+	return nil
+}
+
+type WinnerResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points     int32           `protobuf:"varint,1,opt,name=points,proto3" json:"points,omitempty"`
+	Conditions map[int32]int32 `protobuf:"bytes,2,rep,name=conditions,proto3" json:"conditions,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Context    *WinContext     `protobuf:"bytes,3,opt,name=context,proto3" json:"context,omitempty"`
+}
+
+func (x *WinnerResult) Reset() {
+	*x = WinnerResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WinnerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WinnerResult) ProtoMessage() {}
+
+func (x *WinnerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WinnerResult.ProtoReflect.Descriptor instead.
+func (*WinnerResult) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *WinnerResult) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *WinnerResult) GetConditions() map[int32]int32 {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *WinnerResult) GetContext() *WinContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type Payment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From   int32 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To     int32 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	Points int32 `protobuf:"varint,3,opt,name=points,proto3" json:"points,omitempty"`
+	Amount int32 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Payment) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *Payment) GetTo() int32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *Payment) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *Payment) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type Result struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsDrawnGame       bool                    `protobuf:"varint,1,opt,name=is_drawn_game,json=isDrawnGame,proto3" json:"is_drawn_game,omitempty"`
+	DiscardingPlayer  int32                   `protobuf:"varint,2,opt,name=discarding_player,json=discardingPlayer,proto3" json:"discarding_player,omitempty"`
+	WinningTile       string                  `protobuf:"bytes,3,opt,name=winning_tile,json=winningTile,proto3" json:"winning_tile,omitempty"`
+	Winners           map[int32]*WinnerResult `protobuf:"bytes,4,rep,name=winners,proto3" json:"winners,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Payments          []*Payment              `protobuf:"bytes,5,rep,name=payments,proto3" json:"payments,omitempty"`
+	Deltas            map[int32]int32         `protobuf:"bytes,6,rep,name=deltas,proto3" json:"deltas,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	NextWinningStreak int32                   `protobuf:"varint,7,opt,name=next_winning_streak,json=nextWinningStreak,proto3" json:"next_winning_streak,omitempty"`
+}
+
+func (x *Result) Reset() {
+	*x = Result{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result) ProtoMessage() {}
+
+func (x *Result) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result.ProtoReflect.Descriptor instead.
+func (*Result) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Result) GetIsDrawnGame() bool {
+	if x != nil {
+		return x.IsDrawnGame
+	}
+	return false
+}
+
+func (x *Result) GetDiscardingPlayer() int32 {
+	if x != nil {
+		return x.DiscardingPlayer
+	}
+	return 0
+}
+
+func (x *Result) GetWinningTile() string {
+	if x != nil {
+		return x.WinningTile
+	}
+	return ""
+}
+
+func (x *Result) GetWinners() map[int32]*WinnerResult {
+	if x != nil {
+		return x.Winners
+	}
+	return nil
+}
+
+func (x *Result) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+func (x *Result) GetDeltas() map[int32]int32 {
+	if x != nil {
+		return x.Deltas
+	}
+	return nil
+}
+
+func (x *Result) GetNextWinningStreak() int32 {
+	if x != nil {
+		return x.NextWinningStreak
+	}
+	return 0
+}
+
+type GameState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId    string          `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	CreatedAt int64           `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt int64           `protobuf:"varint,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Meta      *Meta           `protobuf:"bytes,4,opt,name=meta,proto3" json:"meta,omitempty"`
+	Players   []*PlayerState  `protobuf:"bytes,5,rep,name=players,proto3" json:"players,omitempty"`
+	Status    *Status         `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Result    *Result         `protobuf:"bytes,7,opt,name=result,proto3" json:"result,omitempty"`
+	Revision  int64           `protobuf:"varint,8,opt,name=revision,proto3" json:"revision,omitempty"`
+	Actions   []*RecordAction `protobuf:"bytes,9,rep,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *GameState) Reset() {
+	*x = GameState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameState) ProtoMessage() {}
+
+func (x *GameState) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
+func (*GameState) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GameState) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameState) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *GameState) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *GameState) GetMeta() *Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *GameState) GetPlayers() []*PlayerState {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameState) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GameState) GetResult() *Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *GameState) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GameState) GetActions() []*RecordAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type RecordAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Player int32    `protobuf:"varint,2,opt,name=player,proto3" json:"player,omitempty"`
+	Action string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Tile   string   `protobuf:"bytes,4,opt,name=tile,proto3" json:"tile,omitempty"`
+	Tiles  []string `protobuf:"bytes,5,rep,name=tiles,proto3" json:"tiles,omitempty"`
+}
+
+func (x *RecordAction) Reset() {
+	*x = RecordAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAction) ProtoMessage() {}
+
+func (x *RecordAction) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAction.ProtoReflect.Descriptor instead.
+func (*RecordAction) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RecordAction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RecordAction) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *RecordAction) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RecordAction) GetTile() string {
+	if x != nil {
+		return x.Tile
+	}
+	return ""
+}
+
+func (x *RecordAction) GetTiles() []string {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+type StartGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ruleset        string   `protobuf:"bytes,1,opt,name=ruleset,proto3" json:"ruleset,omitempty"`
+	Dices          []int32  `protobuf:"varint,2,rep,packed,name=dices,proto3" json:"dices,omitempty"`
+	Tiles          []string `protobuf:"bytes,3,rep,name=tiles,proto3" json:"tiles,omitempty"`
+	Banker         int32    `protobuf:"varint,4,opt,name=banker,proto3" json:"banker,omitempty"`
+	PrevailingWind string   `protobuf:"bytes,5,opt,name=prevailing_wind,json=prevailingWind,proto3" json:"prevailing_wind,omitempty"`
+	WinningStreak  int32    `protobuf:"varint,6,opt,name=winning_streak,json=winningStreak,proto3" json:"winning_streak,omitempty"`
+}
+
+func (x *StartGameRequest) Reset() {
+	*x = StartGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameRequest) ProtoMessage() {}
+
+func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
+func (*StartGameRequest) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *StartGameRequest) GetRuleset() string {
+	if x != nil {
+		return x.Ruleset
+	}
+	return ""
+}
+
+func (x *StartGameRequest) GetDices() []int32 {
+	if x != nil {
+		return x.Dices
+	}
+	return nil
+}
+
+func (x *StartGameRequest) GetTiles() []string {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+func (x *StartGameRequest) GetBanker() int32 {
+	if x != nil {
+		return x.Banker
+	}
+	return 0
+}
+
+func (x *StartGameRequest) GetPrevailingWind() string {
+	if x != nil {
+		return x.PrevailingWind
+	}
+	return ""
+}
+
+func (x *StartGameRequest) GetWinningStreak() int32 {
+	if x != nil {
+		return x.WinningStreak
+	}
+	return 0
+}
+
+type GetStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Viewer *int32 `protobuf:"varint,2,opt,name=viewer,proto3,oneof" json:"viewer,omitempty"`
+}
+
+func (x *GetStateRequest) Reset() {
+	*x = GetStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateRequest) ProtoMessage() {}
+
+func (x *GetStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateRequest.ProtoReflect.Descriptor instead.
+func (*GetStateRequest) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetStateRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GetStateRequest) GetViewer() int32 {
+	if x != nil && x.Viewer != nil {
+		return *x.Viewer
+	}
+	return 0
+}
+
+type ActRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Action string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Player int32  `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *ActRequest) Reset() {
+	*x = ActRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActRequest) ProtoMessage() {}
+
+func (x *ActRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActRequest.ProtoReflect.Descriptor instead.
+func (*ActRequest) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ActRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ActRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ActRequest) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+type ReactRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId   string   `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Player   int32    `protobuf:"varint,2,opt,name=player,proto3" json:"player,omitempty"`
+	Reaction string   `protobuf:"bytes,3,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Tiles    []string `protobuf:"bytes,4,rep,name=tiles,proto3" json:"tiles,omitempty"`
+}
+
+func (x *ReactRequest) Reset() {
+	*x = ReactRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactRequest) ProtoMessage() {}
+
+func (x *ReactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactRequest.ProtoReflect.Descriptor instead.
+func (*ReactRequest) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ReactRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ReactRequest) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *ReactRequest) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *ReactRequest) GetTiles() []string {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+type DiscardTileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Tile   string `protobuf:"bytes,2,opt,name=tile,proto3" json:"tile,omitempty"`
+	Player int32  `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *DiscardTileRequest) Reset() {
+	*x = DiscardTileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscardTileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardTileRequest) ProtoMessage() {}
+
+func (x *DiscardTileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardTileRequest.ProtoReflect.Descriptor instead.
+func (*DiscardTileRequest) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DiscardTileRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *DiscardTileRequest) GetTile() string {
+	if x != nil {
+		return x.Tile
+	}
+	return ""
+}
+
+func (x *DiscardTileRequest) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+type ReadyHandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Tile   string `protobuf:"bytes,2,opt,name=tile,proto3" json:"tile,omitempty"`
+	Player int32  `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *ReadyHandRequest) Reset() {
+	*x = ReadyHandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadyHandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadyHandRequest) ProtoMessage() {}
+
+func (x *ReadyHandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadyHandRequest.ProtoReflect.Descriptor instead.
+func (*ReadyHandRequest) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReadyHandRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ReadyHandRequest) GetTile() string {
+	if x != nil {
+		return x.Tile
+	}
+	return ""
+}
+
+func (x *ReadyHandRequest) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+type WatchStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Viewer *int32 `protobuf:"varint,2,opt,name=viewer,proto3,oneof" json:"viewer,omitempty"`
+}
+
+func (x *WatchStateRequest) Reset() {
+	*x = WatchStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchStateRequest) ProtoMessage() {}
+
+func (x *WatchStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchStateRequest.ProtoReflect.Descriptor instead.
+func (*WatchStateRequest) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WatchStateRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *WatchStateRequest) GetViewer() int32 {
+	if x != nil && x.Viewer != nil {
+		return *x.Viewer
+	}
+	return 0
+}
+
+type StateUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event GameEvent  `protobuf:"varint,1,opt,name=event,proto3,enum=foursquare.GameEvent" json:"event,omitempty"`
+	State *GameState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *StateUpdate) Reset() {
+	*x = StateUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_foursquare_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateUpdate) ProtoMessage() {}
+
+func (x *StateUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_foursquare_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateUpdate.ProtoReflect.Descriptor instead.
+func (*StateUpdate) Descriptor() ([]byte, []int) {
+	return file_foursquare_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StateUpdate) GetEvent() GameEvent {
+	if x != nil {
+		return x.Event
+	}
+	return GameEvent_GameStarted
+}
+
+func (x *StateUpdate) GetState() *GameState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+var File_foursquare_proto protoreflect.FileDescriptor
+
+var file_foursquare_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0a, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x22, 0x7d,
+	0x0a, 0x07, 0x54, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x75, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x75, 0x69, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x66, 0x0a,
+	0x0a, 0x54, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x12, 0x29, 0x0a, 0x05, 0x73,
+	0x75, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x6f, 0x75,
+	0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x54, 0x69, 0x6c, 0x65, 0x44, 0x65, 0x66, 0x52,
+	0x05, 0x73, 0x75, 0x69, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x77, 0x69, 0x6c, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72,
+	0x65, 0x2e, 0x57, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x52, 0x04, 0x77, 0x69, 0x6c, 0x64, 0x4a,
+	0x04, 0x08, 0x01, 0x10, 0x08, 0x22, 0x6c, 0x0a, 0x07, 0x57, 0x69, 0x6c, 0x64, 0x44, 0x65, 0x66,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x5f, 0x65, 0x79, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x45, 0x79, 0x65, 0x73, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x69, 0x6e, 0x43, 0x68, 0x6f, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x6e, 0x43, 0x6c,
+	0x61, 0x69, 0x6d, 0x22, 0x6b, 0x0a, 0x09, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6d,
+	0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x07, 0x69, 0x6d, 0x70,
+	0x6c, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73,
+	0x22, 0x9c, 0x01, 0x0a, 0x0b, 0x46, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x65, 0x61, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x6b, 0x6f, 0x6e, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4b, 0x6f,
+	0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x6d, 0x6d, 0x6f,
+	0x72, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x49, 0x6d, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x73,
+	0x65, 0x76, 0x65, 0x6e, 0x5f, 0x72, 0x6f, 0x62, 0x5f, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x73, 0x65, 0x76, 0x65, 0x6e, 0x52, 0x6f, 0x62, 0x4f, 0x6e, 0x65, 0x22,
+	0xcb, 0x01, 0x0a, 0x07, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x63, 0x68, 0x6f, 0x77, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x6f, 0x77, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x73, 0x75, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x53, 0x75, 0x69, 0x74, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x62, 0x6f, 0x6e, 0x75, 0x73, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x61, 0x74, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x61, 0x74, 0x73, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x03, 0x10, 0x04, 0x4a,
+	0x04, 0x08, 0x04, 0x10, 0x05, 0x4a, 0x04, 0x08, 0x09, 0x10, 0x0a, 0x4a, 0x04, 0x08, 0x0a, 0x10,
+	0x0b, 0x4a, 0x04, 0x08, 0x0b, 0x10, 0x0c, 0x4a, 0x04, 0x08, 0x0c, 0x10, 0x0d, 0x22, 0xc1, 0x05,
+	0x0a, 0x04, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x65,
+	0x74, 0x5f, 0x64, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x6f,
+	0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x54, 0x69, 0x6c, 0x65, 0x53, 0x65, 0x74,
+	0x44, 0x65, 0x66, 0x52, 0x0a, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x44, 0x65, 0x66, 0x12,
+	0x25, 0x0a, 0x0e, 0x68, 0x61, 0x6e, 0x64, 0x74, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x68, 0x61, 0x6e, 0x64, 0x74, 0x69, 0x6c,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x05, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x61, 0x6e, 0x6b, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x62, 0x61,
+	0x6e, 0x6b, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x61, 0x69, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70,
+	0x72, 0x65, 0x76, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e, 0x64, 0x12, 0x2d, 0x0a,
+	0x13, 0x62, 0x61, 0x6e, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x79, 0x73, 0x5f, 0x6f, 0x6e,
+	0x5f, 0x77, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x62, 0x61, 0x6e, 0x6b,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x79, 0x73, 0x4f, 0x6e, 0x57, 0x69, 0x6e, 0x12, 0x2f, 0x0a, 0x14,
+	0x62, 0x61, 0x6e, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x79, 0x73, 0x5f, 0x6f, 0x6e, 0x5f,
+	0x64, 0x72, 0x61, 0x77, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x62, 0x61, 0x6e, 0x6b,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x79, 0x73, 0x4f, 0x6e, 0x44, 0x72, 0x61, 0x77, 0x12, 0x41, 0x0a,
+	0x0b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e,
+	0x4d, 0x65, 0x74, 0x61, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x3a, 0x0a, 0x0c, 0x66, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75,
+	0x61, 0x72, 0x65, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x0b, 0x66, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x07,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x53,
+	0x65, 0x74, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x65, 0x74, 0x1a, 0x54, 0x0a, 0x0f, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x3c, 0x0a, 0x08, 0x48, 0x61, 0x6e, 0x64, 0x4b, 0x6f, 0x6e, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x70, 0x65,
+	0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x63, 0x65, 0x61, 0x6c, 0x65, 0x64, 0x22,
+	0x1d, 0x0a, 0x05, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xd5,
+	0x01, 0x0a, 0x04, 0x48, 0x61, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x6c, 0x6f, 0x77, 0x65, 0x72,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x74, 0x73, 0x12, 0x2d, 0x0a,
+	0x08, 0x73, 0x74, 0x72, 0x61, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x54, 0x69, 0x6c,
+	0x65, 0x73, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a, 0x04,
+	0x6b, 0x6f, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x66, 0x6f, 0x75,
+	0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x4b, 0x6f, 0x6e, 0x67,
+	0x52, 0x04, 0x6b, 0x6f, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x72, 0x61, 0x77, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x72, 0x61, 0x77,
+	0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x6c, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x77, 0x69, 0x6c, 0x64, 0x73, 0x22, 0x5c, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72,
+	0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69,
+	0x73, 0x63, 0x61, 0x72, 0x64, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6c,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x54,
+	0x69, 0x6c, 0x65, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71,
+	0x75, 0x61, 0x72, 0x65, 0x2e, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x50, 0x0a, 0x15, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f,
+	0x68, 0x61, 0x6e, 0x64, 0x5f, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61,
+	0x72, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x13, 0x72, 0x65, 0x61, 0x64, 0x79, 0x48, 0x61, 0x6e, 0x64, 0x43, 0x61,
+	0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x0b, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x69, 0x64, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73,
+	0x5f, 0x62, 0x61, 0x6e, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69,
+	0x73, 0x42, 0x61, 0x6e, 0x6b, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x69, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x69, 0x6e, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x69,
+	0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x48, 0x61, 0x6e, 0x64, 0x12,
+	0x24, 0x0a, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x52,
+	0x04, 0x68, 0x61, 0x6e, 0x64, 0x12, 0x3b, 0x0a, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xf8, 0x01, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x75, 0x72, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x75, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x75,
+	0x72, 0x5f, 0x74, 0x70, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x75,
+	0x72, 0x54, 0x70, 0x6f, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x5f, 0x73, 0x70, 0x6f,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x75, 0x72, 0x53, 0x70, 0x6f, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x63, 0x75, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x61, 0x72, 0x65, 0x61, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x41, 0x72,
+	0x65, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f,
+	0x6b, 0x6f, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x4b, 0x6f, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x6b,
+	0x6f, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x61, 0x64, 0x64, 0x65, 0x64, 0x4b, 0x6f, 0x6e, 0x67, 0x54, 0x69, 0x6c, 0x65, 0x22, 0xaa, 0x03,
+	0x0a, 0x0a, 0x57, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x77, 0x69, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d,
+	0x69, 0x73, 0x5f, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x65, 0x6c, 0x66, 0x44, 0x72, 0x61, 0x77, 0x6e,
+	0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x6b, 0x6f, 0x6e,
+	0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x4b, 0x6f, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0c, 0x69, 0x73, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x74, 0x69, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x4c, 0x61,
+	0x73, 0x74, 0x54, 0x69, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x73, 0x5f, 0x72, 0x6f, 0x62,
+	0x62, 0x65, 0x64, 0x5f, 0x6b, 0x6f, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x69, 0x73, 0x52, 0x6f, 0x62, 0x62, 0x65, 0x64, 0x4b, 0x6f, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0d,
+	0x69, 0x73, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x52, 0x65, 0x61, 0x64, 0x79, 0x48, 0x61, 0x6e, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x74, 0x75, 0x72, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x69, 0x73, 0x5f, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x69, 0x6d, 0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x69, 0x73, 0x45, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6d, 0x6d, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x69, 0x73, 0x5f, 0x73, 0x65, 0x76, 0x65, 0x6e, 0x5f, 0x72,
+	0x6f, 0x62, 0x5f, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69, 0x73,
+	0x53, 0x65, 0x76, 0x65, 0x6e, 0x52, 0x6f, 0x62, 0x4f, 0x6e, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x77,
+	0x69, 0x6c, 0x64, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x77, 0x69, 0x6c, 0x64, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xe1, 0x01, 0x0a, 0x0c, 0x57,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x48, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71,
+	0x75, 0x61, 0x72, 0x65, 0x2e, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a,
+	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x57, 0x69, 0x6e, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x1a,
+	0x3d, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5d,
+	0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xe1, 0x03,
+	0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x64,
+	0x72, 0x61, 0x77, 0x6e, 0x5f, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x69, 0x73, 0x44, 0x72, 0x61, 0x77, 0x6e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6c, 0x65, 0x12, 0x39, 0x0a, 0x07,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x2e, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x6f, 0x75, 0x72,
+	0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73,
+	0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x73,
+	0x12, 0x2e, 0x0a, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6e,
+	0x65, 0x78, 0x74, 0x57, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b,
+	0x1a, 0x54, 0x0a, 0x0c, 0x57, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x57,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0xe3, 0x02, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72,
+	0x65, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x31, 0x0a, 0x07,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12,
+	0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x6f,
+	0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72,
+	0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7c, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x05, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x62, 0x61, 0x6e, 0x6b, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76,
+	0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x61, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x57, 0x69, 0x6e,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x22, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67,
+	0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x22, 0x55, 0x0a, 0x0a,
+	0x41, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d,
+	0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x22, 0x71, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x12, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72,
+	0x64, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67,
+	0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x22, 0x57, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x79, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69,
+	0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x54, 0x0a, 0x11, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x76, 0x69, 0x65, 0x77,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72,
+	0x22, 0x67, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x2b, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x6f,
+	0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2a, 0xbe, 0x03, 0x0a, 0x09, 0x47, 0x61,
+	0x6d, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x61, 0x6d, 0x65,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x52, 0x65, 0x61, 0x64, 0x79, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04,
+	0x43, 0x68, 0x6f, 0x77, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x75, 0x6e, 0x67, 0x10, 0x05,
+	0x12, 0x0a, 0x0a, 0x06, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04,
+	0x4b, 0x6f, 0x6e, 0x67, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x63, 0x65, 0x61,
+	0x6c, 0x65, 0x64, 0x4b, 0x6f, 0x6e, 0x67, 0x10, 0x08, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x72, 0x61,
+	0x77, 0x6e, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x54, 0x69,
+	0x6c, 0x65, 0x44, 0x72, 0x61, 0x77, 0x6e, 0x10, 0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x69, 0x6c,
+	0x65, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x65, 0x64, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b,
+	0x4e, 0x6f, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x10, 0x0c, 0x12, 0x0f, 0x0a,
+	0x0b, 0x4e, 0x6f, 0x4d, 0x6f, 0x72, 0x65, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x10, 0x0d, 0x12, 0x0d,
+	0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x44, 0x72, 0x61, 0x77, 0x6e, 0x10, 0x0e, 0x12, 0x07, 0x0a,
+	0x03, 0x57, 0x69, 0x6e, 0x10, 0x0f, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x10, 0x10, 0x12, 0x0e, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x10, 0x11, 0x12, 0x10, 0x0a, 0x0c, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f,
+	0x72, 0x52, 0x65, 0x61, 0x64, 0x79, 0x10, 0x12, 0x12, 0x17, 0x0a, 0x13, 0x57, 0x61, 0x69, 0x74,
+	0x46, 0x6f, 0x72, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10,
+	0x13, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x54, 0x6f, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x54, 0x69, 0x6c, 0x65, 0x10,
+	0x14, 0x12, 0x13, 0x0a, 0x0f, 0x57, 0x61, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x10, 0x15, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x65, 0x64, 0x4b,
+	0x6f, 0x6e, 0x67, 0x10, 0x16, 0x12, 0x0e, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x4b,
+	0x6f, 0x6e, 0x67, 0x10, 0x17, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6d,
+	0x6d, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x73, 0x10, 0x18, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x65, 0x76,
+	0x65, 0x6e, 0x52, 0x6f, 0x62, 0x4f, 0x6e, 0x65, 0x10, 0x19, 0x32, 0xce, 0x03, 0x0a, 0x0a, 0x46,
+	0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75,
+	0x61, 0x72, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72,
+	0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71,
+	0x75, 0x61, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72,
+	0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x03, 0x41,
+	0x63, 0x74, 0x12, 0x16, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e,
+	0x41, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x66, 0x6f, 0x75,
+	0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x38, 0x0a, 0x05, 0x52, 0x65, 0x61, 0x63, 0x74, 0x12, 0x18, 0x2e, 0x66, 0x6f, 0x75,
+	0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72,
+	0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x44,
+	0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x54, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x2e, 0x66, 0x6f, 0x75,
+	0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x54,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x66, 0x6f, 0x75,
+	0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x40, 0x0a, 0x09, 0x52, 0x65, 0x61, 0x64, 0x79, 0x48, 0x61, 0x6e, 0x64, 0x12, 0x1c,
+	0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x79, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x66,
+	0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1d, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x42, 0x22, 0x5a, 0x20, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x65, 0x64, 0x62, 0x6f,
+	0x78, 0x2f, 0x66, 0x6f, 0x75, 0x72, 0x73, 0x71, 0x75, 0x61, 0x72, 0x65, 0x2f, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_foursquare_proto_rawDescOnce sync.Once
+	file_foursquare_proto_rawDescData = file_foursquare_proto_rawDesc
+)
+
+func file_foursquare_proto_rawDescGZIP() []byte {
+	file_foursquare_proto_rawDescOnce.Do(func() {
+		file_foursquare_proto_rawDescData = protoimpl.X.CompressGZIP(file_foursquare_proto_rawDescData)
+	})
+	return file_foursquare_proto_rawDescData
+}
+
+var file_foursquare_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_foursquare_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_foursquare_proto_goTypes = []interface{}{
+	(GameEvent)(0),             // 0: foursquare.GameEvent
+	(*TileDef)(nil),            // 1: foursquare.TileDef
+	(*TileSetDef)(nil),         // 2: foursquare.TileSetDef
+	(*WildDef)(nil),            // 3: foursquare.WildDef
+	(*PointRule)(nil),          // 4: foursquare.PointRule
+	(*FlowerRules)(nil),        // 5: foursquare.FlowerRules
+	(*RuleSet)(nil),            // 6: foursquare.RuleSet
+	(*Meta)(nil),               // 7: foursquare.Meta
+	(*HandKong)(nil),           // 8: foursquare.HandKong
+	(*Tiles)(nil),              // 9: foursquare.Tiles
+	(*Hand)(nil),               // 10: foursquare.Hand
+	(*DiscardCandidate)(nil),   // 11: foursquare.DiscardCandidate
+	(*Action)(nil),             // 12: foursquare.Action
+	(*PlayerState)(nil),        // 13: foursquare.PlayerState
+	(*Status)(nil),             // 14: foursquare.Status
+	(*WinContext)(nil),         // 15: foursquare.WinContext
+	(*WinnerResult)(nil),       // 16: foursquare.WinnerResult
+	(*Payment)(nil),            // 17: foursquare.Payment
+	(*Result)(nil),             // 18: foursquare.Result
+	(*GameState)(nil),          // 19: foursquare.GameState
+	(*RecordAction)(nil),       // 20: foursquare.RecordAction
+	(*StartGameRequest)(nil),   // 21: foursquare.StartGameRequest
+	(*GetStateRequest)(nil),    // 22: foursquare.GetStateRequest
+	(*ActRequest)(nil),         // 23: foursquare.ActRequest
+	(*ReactRequest)(nil),       // 24: foursquare.ReactRequest
+	(*DiscardTileRequest)(nil), // 25: foursquare.DiscardTileRequest
+	(*ReadyHandRequest)(nil),   // 26: foursquare.ReadyHandRequest
+	(*WatchStateRequest)(nil),  // 27: foursquare.WatchStateRequest
+	(*StateUpdate)(nil),        // 28: foursquare.StateUpdate
+	nil,                        // 29: foursquare.Meta.PointRulesEntry
+	nil,                        // 30: foursquare.WinnerResult.ConditionsEntry
+	nil,                        // 31: foursquare.Result.WinnersEntry
+	nil,                        // 32: foursquare.Result.DeltasEntry
+}
+var file_foursquare_proto_depIdxs = []int32{
+	1,  // 0: foursquare.TileSetDef.suits:type_name -> foursquare.TileDef
+	3,  // 1: foursquare.TileSetDef.wild:type_name -> foursquare.WildDef
+	2,  // 2: foursquare.Meta.tileset_def:type_name -> foursquare.TileSetDef
+	29, // 3: foursquare.Meta.point_rules:type_name -> foursquare.Meta.PointRulesEntry
+	5,  // 4: foursquare.Meta.flower_rules:type_name -> foursquare.FlowerRules
+	6,  // 5: foursquare.Meta.ruleset:type_name -> foursquare.RuleSet
+	9,  // 6: foursquare.Hand.straight:type_name -> foursquare.Tiles
+	8,  // 7: foursquare.Hand.kong:type_name -> foursquare.HandKong
+	9,  // 8: foursquare.Action.candidates:type_name -> foursquare.Tiles
+	11, // 9: foursquare.Action.ready_hand_candidates:type_name -> foursquare.DiscardCandidate
+	10, // 10: foursquare.PlayerState.hand:type_name -> foursquare.Hand
+	12, // 11: foursquare.PlayerState.allowed_actions:type_name -> foursquare.Action
+	30, // 12: foursquare.WinnerResult.conditions:type_name -> foursquare.WinnerResult.ConditionsEntry
+	15, // 13: foursquare.WinnerResult.context:type_name -> foursquare.WinContext
+	31, // 14: foursquare.Result.winners:type_name -> foursquare.Result.WinnersEntry
+	17, // 15: foursquare.Result.payments:type_name -> foursquare.Payment
+	32, // 16: foursquare.Result.deltas:type_name -> foursquare.Result.DeltasEntry
+	7,  // 17: foursquare.GameState.meta:type_name -> foursquare.Meta
+	13, // 18: foursquare.GameState.players:type_name -> foursquare.PlayerState
+	14, // 19: foursquare.GameState.status:type_name -> foursquare.Status
+	18, // 20: foursquare.GameState.result:type_name -> foursquare.Result
+	20, // 21: foursquare.GameState.actions:type_name -> foursquare.RecordAction
+	0,  // 22: foursquare.StateUpdate.event:type_name -> foursquare.GameEvent
+	19, // 23: foursquare.StateUpdate.state:type_name -> foursquare.GameState
+	4,  // 24: foursquare.Meta.PointRulesEntry.value:type_name -> foursquare.PointRule
+	16, // 25: foursquare.Result.WinnersEntry.value:type_name -> foursquare.WinnerResult
+	21, // 26: foursquare.Foursquare.StartGame:input_type -> foursquare.StartGameRequest
+	22, // 27: foursquare.Foursquare.GetState:input_type -> foursquare.GetStateRequest
+	23, // 28: foursquare.Foursquare.Act:input_type -> foursquare.ActRequest
+	24, // 29: foursquare.Foursquare.React:input_type -> foursquare.ReactRequest
+	25, // 30: foursquare.Foursquare.DiscardTile:input_type -> foursquare.DiscardTileRequest
+	26, // 31: foursquare.Foursquare.ReadyHand:input_type -> foursquare.ReadyHandRequest
+	27, // 32: foursquare.Foursquare.WatchState:input_type -> foursquare.WatchStateRequest
+	19, // 33: foursquare.Foursquare.StartGame:output_type -> foursquare.GameState
+	19, // 34: foursquare.Foursquare.GetState:output_type -> foursquare.GameState
+	19, // 35: foursquare.Foursquare.Act:output_type -> foursquare.GameState
+	19, // 36: foursquare.Foursquare.React:output_type -> foursquare.GameState
+	19, // 37: foursquare.Foursquare.DiscardTile:output_type -> foursquare.GameState
+	19, // 38: foursquare.Foursquare.ReadyHand:output_type -> foursquare.GameState
+	28, // 39: foursquare.Foursquare.WatchState:output_type -> foursquare.StateUpdate
+	33, // [33:40] is the sub-list for method output_type
+	26, // [26:33] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
+}
+
+func init() { file_foursquare_proto_init() }
+func file_foursquare_proto_init() {
+	if File_foursquare_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_foursquare_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TileDef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TileSetDef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WildDef); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PointRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowerRules); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RuleSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Meta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandKong); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tiles); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hand); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardCandidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Action); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WinContext); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WinnerResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Payment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardTileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadyHandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_foursquare_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_foursquare_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_foursquare_proto_msgTypes[26].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_foursquare_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   32,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_foursquare_proto_goTypes,
+		DependencyIndexes: file_foursquare_proto_depIdxs,
+		EnumInfos:         file_foursquare_proto_enumTypes,
+		MessageInfos:      file_foursquare_proto_msgTypes,
+	}.Build()
+	File_foursquare_proto = out.File
+	file_foursquare_proto_rawDesc = nil
+	file_foursquare_proto_goTypes = nil
+	file_foursquare_proto_depIdxs = nil
+}
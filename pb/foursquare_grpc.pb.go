@@ -0,0 +1,359 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: foursquare.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Foursquare_StartGame_FullMethodName   = "/foursquare.Foursquare/StartGame"
+	Foursquare_GetState_FullMethodName    = "/foursquare.Foursquare/GetState"
+	Foursquare_Act_FullMethodName         = "/foursquare.Foursquare/Act"
+	Foursquare_React_FullMethodName       = "/foursquare.Foursquare/React"
+	Foursquare_DiscardTile_FullMethodName = "/foursquare.Foursquare/DiscardTile"
+	Foursquare_ReadyHand_FullMethodName   = "/foursquare.Foursquare/ReadyHand"
+	Foursquare_WatchState_FullMethodName  = "/foursquare.Foursquare/WatchState"
+)
+
+// FoursquareClient is the client API for Foursquare service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type FoursquareClient interface {
+	StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*GameState, error)
+	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GameState, error)
+	Act(ctx context.Context, in *ActRequest, opts ...grpc.CallOption) (*GameState, error)
+	React(ctx context.Context, in *ReactRequest, opts ...grpc.CallOption) (*GameState, error)
+	DiscardTile(ctx context.Context, in *DiscardTileRequest, opts ...grpc.CallOption) (*GameState, error)
+	ReadyHand(ctx context.Context, in *ReadyHandRequest, opts ...grpc.CallOption) (*GameState, error)
+	WatchState(ctx context.Context, in *WatchStateRequest, opts ...grpc.CallOption) (Foursquare_WatchStateClient, error)
+}
+
+type foursquareClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewFoursquareClient(cc grpc.ClientConnInterface) FoursquareClient {
+	return &foursquareClient{cc}
+}
+
+func (c *foursquareClient) StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*GameState, error) {
+	out := new(GameState)
+	err := c.cc.Invoke(ctx, Foursquare_StartGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foursquareClient) GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GameState, error) {
+	out := new(GameState)
+	err := c.cc.Invoke(ctx, Foursquare_GetState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foursquareClient) Act(ctx context.Context, in *ActRequest, opts ...grpc.CallOption) (*GameState, error) {
+	out := new(GameState)
+	err := c.cc.Invoke(ctx, Foursquare_Act_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foursquareClient) React(ctx context.Context, in *ReactRequest, opts ...grpc.CallOption) (*GameState, error) {
+	out := new(GameState)
+	err := c.cc.Invoke(ctx, Foursquare_React_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foursquareClient) DiscardTile(ctx context.Context, in *DiscardTileRequest, opts ...grpc.CallOption) (*GameState, error) {
+	out := new(GameState)
+	err := c.cc.Invoke(ctx, Foursquare_DiscardTile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foursquareClient) ReadyHand(ctx context.Context, in *ReadyHandRequest, opts ...grpc.CallOption) (*GameState, error) {
+	out := new(GameState)
+	err := c.cc.Invoke(ctx, Foursquare_ReadyHand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *foursquareClient) WatchState(ctx context.Context, in *WatchStateRequest, opts ...grpc.CallOption) (Foursquare_WatchStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Foursquare_ServiceDesc.Streams[0], Foursquare_WatchState_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &foursquareWatchStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Foursquare_WatchStateClient interface {
+	Recv() (*StateUpdate, error)
+	grpc.ClientStream
+}
+
+type foursquareWatchStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *foursquareWatchStateClient) Recv() (*StateUpdate, error) {
+	m := new(StateUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// FoursquareServer is the server API for Foursquare service.
+// All implementations must embed UnimplementedFoursquareServer
+// for forward compatibility
+type FoursquareServer interface {
+	StartGame(context.Context, *StartGameRequest) (*GameState, error)
+	GetState(context.Context, *GetStateRequest) (*GameState, error)
+	Act(context.Context, *ActRequest) (*GameState, error)
+	React(context.Context, *ReactRequest) (*GameState, error)
+	DiscardTile(context.Context, *DiscardTileRequest) (*GameState, error)
+	ReadyHand(context.Context, *ReadyHandRequest) (*GameState, error)
+	WatchState(*WatchStateRequest, Foursquare_WatchStateServer) error
+	mustEmbedUnimplementedFoursquareServer()
+}
+
+// UnimplementedFoursquareServer must be embedded to have forward compatible implementations.
+type UnimplementedFoursquareServer struct {
+}
+
+func (UnimplementedFoursquareServer) StartGame(context.Context, *StartGameRequest) (*GameState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartGame not implemented")
+}
+func (UnimplementedFoursquareServer) GetState(context.Context, *GetStateRequest) (*GameState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetState not implemented")
+}
+func (UnimplementedFoursquareServer) Act(context.Context, *ActRequest) (*GameState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Act not implemented")
+}
+func (UnimplementedFoursquareServer) React(context.Context, *ReactRequest) (*GameState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method React not implemented")
+}
+func (UnimplementedFoursquareServer) DiscardTile(context.Context, *DiscardTileRequest) (*GameState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscardTile not implemented")
+}
+func (UnimplementedFoursquareServer) ReadyHand(context.Context, *ReadyHandRequest) (*GameState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadyHand not implemented")
+}
+func (UnimplementedFoursquareServer) WatchState(*WatchStateRequest, Foursquare_WatchStateServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchState not implemented")
+}
+func (UnimplementedFoursquareServer) mustEmbedUnimplementedFoursquareServer() {}
+
+// UnsafeFoursquareServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to FoursquareServer will
+// result in compilation errors.
+type UnsafeFoursquareServer interface {
+	mustEmbedUnimplementedFoursquareServer()
+}
+
+func RegisterFoursquareServer(s grpc.ServiceRegistrar, srv FoursquareServer) {
+	s.RegisterService(&Foursquare_ServiceDesc, srv)
+}
+
+func _Foursquare_StartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoursquareServer).StartGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Foursquare_StartGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoursquareServer).StartGame(ctx, req.(*StartGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Foursquare_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoursquareServer).GetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Foursquare_GetState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoursquareServer).GetState(ctx, req.(*GetStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Foursquare_Act_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoursquareServer).Act(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Foursquare_Act_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoursquareServer).Act(ctx, req.(*ActRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Foursquare_React_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoursquareServer).React(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Foursquare_React_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoursquareServer).React(ctx, req.(*ReactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Foursquare_DiscardTile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscardTileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoursquareServer).DiscardTile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Foursquare_DiscardTile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoursquareServer).DiscardTile(ctx, req.(*DiscardTileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Foursquare_ReadyHand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadyHandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FoursquareServer).ReadyHand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Foursquare_ReadyHand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FoursquareServer).ReadyHand(ctx, req.(*ReadyHandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Foursquare_WatchState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FoursquareServer).WatchState(m, &foursquareWatchStateServer{stream})
+}
+
+type Foursquare_WatchStateServer interface {
+	Send(*StateUpdate) error
+	grpc.ServerStream
+}
+
+type foursquareWatchStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *foursquareWatchStateServer) Send(m *StateUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Foursquare_ServiceDesc is the grpc.ServiceDesc for Foursquare service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Foursquare_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "foursquare.Foursquare",
+	HandlerType: (*FoursquareServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "StartGame",
+			Handler:    _Foursquare_StartGame_Handler,
+		},
+		{
+			MethodName: "GetState",
+			Handler:    _Foursquare_GetState_Handler,
+		},
+		{
+			MethodName: "Act",
+			Handler:    _Foursquare_Act_Handler,
+		},
+		{
+			MethodName: "React",
+			Handler:    _Foursquare_React_Handler,
+		},
+		{
+			MethodName: "DiscardTile",
+			Handler:    _Foursquare_DiscardTile_Handler,
+		},
+		{
+			MethodName: "ReadyHand",
+			Handler:    _Foursquare_ReadyHand_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchState",
+			Handler:       _Foursquare_WatchState_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "foursquare.proto",
+}
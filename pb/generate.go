@@ -0,0 +1,4 @@
+// Package pb defines protobuf messages and gRPC service of the engine, converters map them to structs of foursquare.
+package pb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative foursquare.proto
@@ -0,0 +1,333 @@
+package pb
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/weedbox/foursquare"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrGameNotFound     = errors.New("pb: game not found")
+	ErrUnknownRuleSet   = errors.New("pb: unknown rule set")
+	ErrViewerRequired   = errors.New("pb: viewer is required")
+	ErrNotCurrentPlayer = errors.New("pb: player is not current player")
+)
+
+// Spectator sees tiles of nobody
+const spectator = -1
+
+const watcherBufferSize = 64
+
+// Service implements FoursquareServer, games are kept in memory
+type Service struct {
+	UnimplementedFoursquareServer
+
+	mu    sync.RWMutex
+	games map[string]*serviceGame
+}
+
+type serviceGame struct {
+	mu       sync.Mutex
+	game     *foursquare.Game
+	watchers map[chan *StateUpdate]int // Seat which watcher views
+}
+
+func NewService() *Service {
+	return &Service{
+		games: make(map[string]*serviceGame),
+	}
+}
+
+func (s *Service) getGame(gameID string) (*serviceGame, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sg, ok := s.games[gameID]
+	if !ok {
+		return nil, status.Error(codes.NotFound, ErrGameNotFound.Error())
+	}
+
+	return sg, nil
+}
+
+// StartGame creates a game and deals tiles, game is waiting for the first action of banker
+func (s *Service) StartGame(ctx context.Context, req *StartGameRequest) (*GameState, error) {
+
+	rs, ok := foursquare.TaiwanRuleSet, true
+	if req.Ruleset != "" {
+		rs, ok = foursquare.FindRuleSet(req.Ruleset)
+	}
+
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, ErrUnknownRuleSet.Error())
+	}
+
+	opts := foursquare.NewOptionsWithRuleSet(rs)
+	opts.Banker = int(req.Banker)
+	opts.WinningStreak = int(req.WinningStreak)
+
+	if req.PrevailingWind != "" {
+		opts.PrevailingWind = req.PrevailingWind
+	}
+
+	opts.Dices = toInts(req.Dices)
+	if len(opts.Dices) == 0 {
+		opts.Dices = foursquare.RollDices()
+	}
+
+	opts.Tiles = toStrings(req.Tiles)
+	if len(opts.Tiles) == 0 {
+		opts.Tiles = foursquare.ShuffleTiles(foursquare.NewTileSet(opts.TileSetDef))
+	}
+
+	g := foursquare.NewGame(opts)
+
+	err := g.StartGame()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if g.GetState().Status.CurrentEvent == foursquare.GetGameEventSymbols(foursquare.GameEvent_WaitForReady) {
+		err = g.Ready()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	sg := &serviceGame{
+		game:     g,
+		watchers: make(map[chan *StateUpdate]int),
+	}
+
+	s.mu.Lock()
+	s.games[g.GetState().GameID] = sg
+	s.mu.Unlock()
+
+	return sg.snapshot(spectator), nil
+}
+
+func (s *Service) GetState(ctx context.Context, req *GetStateRequest) (*GameState, error) {
+
+	if req.Viewer == nil {
+		return nil, status.Error(codes.InvalidArgument, ErrViewerRequired.Error())
+	}
+
+	sg, err := s.getGame(req.GameId)
+	if err != nil {
+		return nil, err
+	}
+
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+
+	return sg.snapshot(int(*req.Viewer)), nil
+}
+
+func (s *Service) Act(ctx context.Context, req *ActRequest) (*GameState, error) {
+	return s.apply(req.GameId, req.Player, func(g *foursquare.Game) error {
+
+		if err := checkCurrentPlayer(g, req.Player); err != nil {
+			return err
+		}
+
+		return g.Act(req.Action)
+	})
+}
+
+func (s *Service) React(ctx context.Context, req *ReactRequest) (*GameState, error) {
+	return s.apply(req.GameId, req.Player, func(g *foursquare.Game) error {
+		return g.React(int(req.Player), req.Reaction, toStrings(req.Tiles))
+	})
+}
+
+func (s *Service) DiscardTile(ctx context.Context, req *DiscardTileRequest) (*GameState, error) {
+	return s.apply(req.GameId, req.Player, func(g *foursquare.Game) error {
+
+		if err := checkCurrentPlayer(g, req.Player); err != nil {
+			return err
+		}
+
+		return g.DiscardTile(req.Tile)
+	})
+}
+
+func (s *Service) ReadyHand(ctx context.Context, req *ReadyHandRequest) (*GameState, error) {
+	return s.apply(req.GameId, req.Player, func(g *foursquare.Game) error {
+
+		if err := checkCurrentPlayer(g, req.Player); err != nil {
+			return err
+		}
+
+		return g.ReadyHand(req.Tile)
+	})
+}
+
+func checkCurrentPlayer(g *foursquare.Game, player int32) error {
+
+	if int(player) != g.GetState().Status.CurrentPlayer {
+		return ErrNotCurrentPlayer
+	}
+
+	return nil
+}
+
+// apply runs command of player on game, then notifies watchers. Player gets state of the seat.
+func (s *Service) apply(gameID string, player int32, fn func(g *foursquare.Game) error) (*GameState, error) {
+
+	sg, err := s.getGame(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	sg.mu.Lock()
+	defer sg.mu.Unlock()
+
+	err = fn(sg.game)
+	if errors.Is(err, ErrNotCurrentPlayer) {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if err != nil {
+		return nil, commandStatusError(err)
+	}
+
+	sg.notify()
+
+	return sg.snapshot(int(player)), nil
+}
+
+// commandStatusError attaches code and context of rejected command as ErrorInfo
+func commandStatusError(err error) error {
+
+	st := status.New(codes.FailedPrecondition, err.Error())
+
+	code := foursquare.GetErrorCode(err)
+	if code == "" {
+		return st.Err()
+	}
+
+	info := &errdetails.ErrorInfo{
+		Reason:   string(code),
+		Domain:   "foursquare",
+		Metadata: make(map[string]string),
+	}
+
+	var ce *foursquare.CommandError
+	if errors.As(err, &ce) {
+		info.Metadata["command"] = ce.Command
+		info.Metadata["player"] = strconv.Itoa(ce.Player)
+		info.Metadata["event"] = ce.Event
+		info.Metadata["allowed_actions"] = strings.Join(ce.AllowedActions, ",")
+
+		if ce.Action != "" {
+			info.Metadata["action"] = ce.Action
+		}
+
+		if ce.Tile != "" {
+			info.Metadata["tile"] = ce.Tile
+		}
+	}
+
+	dst, derr := st.WithDetails(info)
+	if derr != nil {
+		return st.Err()
+	}
+
+	return dst.Err()
+}
+
+func (s *Service) WatchState(req *WatchStateRequest, stream Foursquare_WatchStateServer) error {
+
+	if req.Viewer == nil {
+		return status.Error(codes.InvalidArgument, ErrViewerRequired.Error())
+	}
+
+	sg, err := s.getGame(req.GameId)
+	if err != nil {
+		return err
+	}
+
+	viewer := int(*req.Viewer)
+	ch := make(chan *StateUpdate, watcherBufferSize)
+
+	sg.mu.Lock()
+	sg.watchers[ch] = viewer
+	current := sg.update(viewer)
+	sg.mu.Unlock()
+
+	defer func() {
+		sg.mu.Lock()
+		delete(sg.watchers, ch)
+		sg.mu.Unlock()
+	}()
+
+	for u := current; ; {
+
+		err := stream.Send(u)
+		if err != nil {
+			return err
+		}
+
+		if u.Event == GameEvent_GameClosed {
+			return nil
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case next, ok := <-ch:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "pb: watcher is too slow")
+			}
+
+			u = next
+		}
+	}
+}
+
+// snapshot returns state which seat of viewer is able to see
+func (sg *serviceGame) snapshot(viewer int) *GameState {
+	return FromGameState(sg.game.GetState().Redact(viewer))
+}
+
+func (sg *serviceGame) update(viewer int) *StateUpdate {
+
+	s := sg.snapshot(viewer)
+	event, _ := GameEventFromSymbol(s.Status.CurEvent)
+
+	return &StateUpdate{
+		Event: event,
+		State: s,
+	}
+}
+
+// notify sends state to watchers, watcher is dropped if it can't keep up
+func (sg *serviceGame) notify() {
+
+	// Watchers of the same seat share the update
+	updates := make(map[int]*StateUpdate)
+
+	for ch, viewer := range sg.watchers {
+
+		u, ok := updates[viewer]
+		if !ok {
+			u = sg.update(viewer)
+			updates[viewer] = u
+		}
+
+		select {
+		case ch <- u:
+		default:
+			close(ch)
+			delete(sg.watchers, ch)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+package pb
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weedbox/foursquare"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestClient(t *testing.T) FoursquareClient {
+
+	lis := bufconn.Listen(1024 * 1024)
+
+	s := grpc.NewServer()
+	RegisterFoursquareServer(s, NewService())
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return lis.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	return NewFoursquareClient(conn)
+}
+
+// view returns state which seat of player is able to see
+func view(ctx context.Context, client FoursquareClient, gameID string, player int) (*foursquare.GameState, *foursquare.PlayerState, error) {
+
+	s, err := client.GetState(ctx, &GetStateRequest{GameId: gameID, Viewer: proto.Int32(int32(player))})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gs := ToGameState(s)
+
+	return gs, &gs.Players[player], nil
+}
+
+// step asks bots for decision with state of their seats, then sends it to server
+func step(ctx context.Context, client FoursquareClient, s *GameState) (*GameState, error) {
+
+	bot := foursquare.NewBot()
+	cur := s.Status.CurPlayer
+
+	switch s.Status.CurEvent {
+	case foursquare.GetGameEventSymbols(foursquare.GameEvent_WaitForPlayerAction):
+
+		gs, ps, err := view(ctx, client, s.GameId, int(cur))
+		if err != nil {
+			return nil, err
+		}
+
+		d, _ := bot.DecideAction(gs, ps)
+
+		return client.Act(ctx, &ActRequest{GameId: s.GameId, Player: cur, Action: d.Action})
+
+	case foursquare.GetGameEventSymbols(foursquare.GameEvent_WaitForPlayerToDiscardTile):
+
+		gs, ps, err := view(ctx, client, s.GameId, int(cur))
+		if err != nil {
+			return nil, err
+		}
+
+		d, _ := bot.DecideDiscard(gs, ps)
+
+		if d.Action == "readyhand" {
+			return client.ReadyHand(ctx, &ReadyHandRequest{GameId: s.GameId, Player: cur, Tile: d.Tile})
+		}
+
+		return client.DiscardTile(ctx, &DiscardTileRequest{GameId: s.GameId, Player: cur, Tile: d.Tile})
+
+	case foursquare.GetGameEventSymbols(foursquare.GameEvent_WaitForReaction):
+
+		// The first player who wants to win, otherwise everyone passes
+		for i := range s.Players {
+
+			gs, ps, err := view(ctx, client, s.GameId, i)
+			if err != nil {
+				return nil, err
+			}
+
+			d, _ := bot.DecideReaction(gs, ps)
+			if d.Action == "win" {
+				return client.React(ctx, &ReactRequest{GameId: s.GameId, Player: int32(i), Reaction: "win"})
+			}
+		}
+
+		return client.React(ctx, &ReactRequest{GameId: s.GameId, Player: -1})
+	}
+
+	return s, nil
+}
+
+func Test_Service_PlayGame(t *testing.T) {
+
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	s, err := client.StartGame(ctx, &StartGameRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), s.Status.CurPlayer)
+
+	// Nobody sits at the table yet
+	assert.Equal(t, foursquare.HiddenTile, s.Meta.Tiles[0])
+
+	stream, err := client.WatchState(ctx, &WatchStateRequest{GameId: s.GameId, Viewer: proto.Int32(1)})
+	assert.Nil(t, err)
+
+	// Current state comes first
+	u, err := stream.Recv()
+	assert.Nil(t, err)
+	assert.Equal(t, s.Status.CurEvent, u.Event.String())
+	assert.Equal(t, foursquare.HiddenTile, u.State.Players[0].Hand.Tiles[0])
+
+	for s.Status.CurEvent != "GameClosed" {
+		s, err = step(ctx, client, s)
+		if !assert.Nil(t, err) {
+			return
+		}
+
+		if s.Result == nil {
+			assert.Equal(t, foursquare.HiddenTile, s.Meta.Tiles[0])
+		}
+	}
+
+	assert.NotNil(t, s.Result)
+
+	// Watcher receives states until game was closed
+	count := 0
+	for {
+		u, err = stream.Recv()
+		if !assert.Nil(t, err) {
+			return
+		}
+
+		count++
+
+		if u.State.Result == nil {
+			assert.Equal(t, foursquare.HiddenTile, u.State.Players[0].Hand.Tiles[0])
+			assert.NotEqual(t, foursquare.HiddenTile, u.State.Players[1].Hand.Tiles[0])
+		}
+
+		if u.Event == GameEvent_GameClosed {
+			break
+		}
+	}
+
+	assert.Greater(t, count, 0)
+
+	// Result is revealed
+	assert.NotEqual(t, foursquare.HiddenTile, u.State.Players[0].Hand.Tiles[0])
+}
+
+func Test_Service_Errors(t *testing.T) {
+
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	_, err := client.GetState(ctx, &GetStateRequest{GameId: "nothing", Viewer: proto.Int32(0)})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.StartGame(ctx, &StartGameRequest{Ruleset: "nothing"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	s, err := client.StartGame(ctx, &StartGameRequest{Ruleset: "hongkong"})
+	assert.Nil(t, err)
+	assert.Equal(t, "hongkong", s.Meta.Ruleset.Name)
+
+	// Banker hasn't discarded anything
+	_, err = client.React(ctx, &ReactRequest{GameId: s.GameId, Player: 1, Reaction: "pung"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	details := status.Convert(err).Details()
+	if assert.Len(t, details, 1) {
+		info, ok := details[0].(*errdetails.ErrorInfo)
+		assert.True(t, ok)
+		assert.Equal(t, string(foursquare.ErrorCode_InvalidGameStatus), info.Reason)
+		assert.Equal(t, "react", info.Metadata["command"])
+		assert.Equal(t, "1", info.Metadata["player"])
+	}
+}
+
+func Test_Service_Redaction(t *testing.T) {
+
+	ctx := context.Background()
+	client := newTestClient(t)
+
+	s, err := client.StartGame(ctx, &StartGameRequest{})
+	assert.Nil(t, err)
+
+	// Viewer is required
+	_, err = client.GetState(ctx, &GetStateRequest{GameId: s.GameId})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	stream, err := client.WatchState(ctx, &WatchStateRequest{GameId: s.GameId})
+	assert.Nil(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Only current player is able to act
+	_, err = client.Act(ctx, &ActRequest{GameId: s.GameId, Player: 1, Action: "discard"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.DiscardTile(ctx, &DiscardTileRequest{GameId: s.GameId, Player: 1, Tile: "W1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = client.ReadyHand(ctx, &ReadyHandRequest{GameId: s.GameId, Player: 1, Tile: "W1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Player sees own hand only
+	s, err = client.Act(ctx, &ActRequest{GameId: s.GameId, Player: 0, Action: "discard"})
+	assert.Nil(t, err)
+	assert.Equal(t, foursquare.HiddenTile, s.Meta.Tiles[0])
+	assert.NotEqual(t, foursquare.HiddenTile, s.Players[0].Hand.Tiles[0])
+	assert.Equal(t, foursquare.HiddenTile, s.Players[1].Hand.Tiles[0])
+}
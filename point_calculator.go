@@ -1,5 +1,9 @@
 package foursquare
 
+import (
+	"sort"
+)
+
 type PointType int32
 
 const (
@@ -41,35 +45,64 @@ const (
 
 	// 門前清
 	ConcealedHand // 門前清
+
+	// 其他條件
+	LastDiscard // 河底撈魚
+
+	// 莊家
+	Banker       // 莊家
+	BankerStreak // 連莊拉莊
+
+	// 風牌及三元牌
+	SeatWind       // 門風
+	PrevailingWind // 圈風
+	DragonPung     // 三元牌
+
+	// 花牌
+	SeatFlower     // 正花
+	FlowerKong     // 花槓
+	EightImmortals // 八仙過海
+	SevenRobOne    // 七搶一
+
+	// 百搭
+	NoWilds // 無百搭，胡牌不靠百搭
 )
 
 type PointRule struct {
 	Type  PointType `json:"type"`
 	Point int       `json:"point"`
+
+	// Implies lists patterns which are part of this pattern, they are not counted if this pattern counts.
+	Implies []PointType `json:"implies,omitempty"`
+
+	// Excludes lists patterns which can not be counted together with this pattern, only the one
+	// with higher points counts. This pattern counts if points are equal.
+	Excludes []PointType `json:"excludes,omitempty"`
 }
 
 type PointCalculator struct {
-	Rules map[PointType]PointRule `json:"rules"`
+	Rules      map[PointType]PointRule `json:"rules"`
+	TileSetDef *TileSetDef             `json:"tileset_def,omitempty"` // Tiles are played as standard tile set if it is nil
 }
 
 var StandardRules map[PointType]PointRule = map[PointType]PointRule{
-	MinimalPoints:       {Type: MinimalPoints, Point: 1},       // 平胡（自摸）
-	PungHand:            {Type: PungHand, Point: 4},            // 碰碰胡
-	HalfFlush:           {Type: HalfFlush, Point: 4},           // 混一色
-	FullFlush:           {Type: FullFlush, Point: 8},           // 清一色
-	AllHonorsHand:       {Type: AllHonorsHand, Point: 8},       // 字一色
-	LittleThreeDragons:  {Type: LittleThreeDragons, Point: 4},  // 小三元
-	BigThreeDragons:     {Type: BigThreeDragons, Point: 8},     // 大三元
-	ThreeConcealedPungs: {Type: ThreeConcealedPungs, Point: 2}, // 三暗刻
-	FourConcealedPungs:  {Type: FourConcealedPungs, Point: 5},  // 四暗刻
-	FiveConcealedPungs:  {Type: FiveConcealedPungs, Point: 8},  // 五暗刻
-	SmallFourWinds:      {Type: SmallFourWinds, Point: 8},      // 小四喜
-	BigFourWinds:        {Type: BigFourWinds, Point: 16},       // 大四喜
-	MeldedHand:          {Type: MeldedHand, Point: 2},          // 全求人
-	HalfMeldedHand:      {Type: MeldedHand, Point: 1},          // 半求人
-
-	HeavenlyHand: {Type: HeavenlyHand, Point: 16}, // 天胡
-	EarthlyHand:  {Type: EarthlyHand, Point: 16},  // 地胡
+	MinimalPoints:       {Type: MinimalPoints, Point: 1},                                                                     // 平胡（自摸）
+	PungHand:            {Type: PungHand, Point: 4},                                                                          // 碰碰胡
+	HalfFlush:           {Type: HalfFlush, Point: 4},                                                                         // 混一色
+	FullFlush:           {Type: FullFlush, Point: 8, Excludes: []PointType{HalfFlush}},                                       // 清一色
+	AllHonorsHand:       {Type: AllHonorsHand, Point: 8, Implies: []PointType{HalfFlush, PungHand}},                          // 字一色
+	LittleThreeDragons:  {Type: LittleThreeDragons, Point: 4, Implies: []PointType{DragonPung}},                              // 小三元
+	BigThreeDragons:     {Type: BigThreeDragons, Point: 8, Implies: []PointType{LittleThreeDragons, DragonPung}},             // 大三元
+	ThreeConcealedPungs: {Type: ThreeConcealedPungs, Point: 2},                                                               // 三暗刻
+	FourConcealedPungs:  {Type: FourConcealedPungs, Point: 5, Implies: []PointType{ThreeConcealedPungs}},                     // 四暗刻
+	FiveConcealedPungs:  {Type: FiveConcealedPungs, Point: 8, Implies: []PointType{FourConcealedPungs, ThreeConcealedPungs}}, // 五暗刻
+	SmallFourWinds:      {Type: SmallFourWinds, Point: 8},                                                                    // 小四喜
+	BigFourWinds:        {Type: BigFourWinds, Point: 16, Implies: []PointType{SmallFourWinds, SeatWind, PrevailingWind}},     // 大四喜
+	MeldedHand:          {Type: MeldedHand, Point: 2, Excludes: []PointType{SelfDrawn}},                                      // 全求人
+	HalfMeldedHand:      {Type: HalfMeldedHand, Point: 1},                                                                    // 半求人
+
+	HeavenlyHand: {Type: HeavenlyHand, Point: 16, Implies: []PointType{SelfDrawn, ConcealedHand}}, // 天胡
+	EarthlyHand:  {Type: EarthlyHand, Point: 16, Implies: []PointType{SelfDrawn, ConcealedHand}},  // 地胡
 
 	FlowerTiles: {Type: FlowerTiles, Point: 1}, // 花牌
 
@@ -85,6 +118,31 @@ var StandardRules map[PointType]PointRule = map[PointType]PointRule{
 	ConcealedKong: {Type: ConcealedKong, Point: 1}, // 暗槓
 
 	ConcealedHand: {Type: ConcealedHand, Point: 1}, // 門前清
+
+	LastDiscard: {Type: LastDiscard, Point: 1}, // 河底撈魚
+
+	Banker:       {Type: Banker, Point: 1},       // 莊家
+	BankerStreak: {Type: BankerStreak, Point: 2}, // 連莊拉莊，每連莊一次
+
+	SeatWind:       {Type: SeatWind, Point: 1},       // 門風
+	PrevailingWind: {Type: PrevailingWind, Point: 1}, // 圈風
+	DragonPung:     {Type: DragonPung, Point: 1},     // 三元牌，每組刻子
+
+	SeatFlower:     {Type: SeatFlower, Point: 1},                                         // 正花，每張
+	FlowerKong:     {Type: FlowerKong, Point: 2},                                         // 花槓，每組
+	EightImmortals: {Type: EightImmortals, Point: 8, Excludes: []PointType{SevenRobOne}}, // 八仙過海
+	SevenRobOne:    {Type: SevenRobOne, Point: 8},                                        // 七搶一
+}
+
+// Points which are counted for winning with flowers
+var flowerWinPointTypes = map[PointType]bool{
+	FlowerTiles:    true,
+	SeatFlower:     true,
+	FlowerKong:     true,
+	EightImmortals: true,
+	SevenRobOne:    true,
+	Banker:         true,
+	BankerStreak:   true,
 }
 
 func NewPointCalculator(rules map[PointType]PointRule) *PointCalculator {
@@ -93,18 +151,327 @@ func NewPointCalculator(rules map[PointType]PointRule) *PointCalculator {
 	}
 }
 
-func (pc *PointCalculator) Calculate(g *Game, ps *PlayerState, hand *Hand) {
-	//TODO
+type pointChecker func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int
+
+var pointCheckers = map[PointType]pointChecker{
+	MinimalPoints: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.MinimalPoints(hand)
+	},
+	PungHand: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.PungHand(hand)
+	},
+	HalfFlush: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.HalfFlush(hand)
+	},
+	FullFlush: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.FullFlush(hand)
+	},
+	LittleThreeDragons: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.LittleThreeDragons(hand)
+	},
+	AllHonorsHand: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.AllHonorsHand(hand)
+	},
+	BigThreeDragons: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.BigThreeDragons(hand)
+	},
+	ThreeConcealedPungs: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.ThreeConcealedPungs(getConcealedPungsHand(pc.TileSetDef, hand, wc))
+	},
+	FourConcealedPungs: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.FourConcealedPungs(getConcealedPungsHand(pc.TileSetDef, hand, wc))
+	},
+	FiveConcealedPungs: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.FiveConcealedPungs(getConcealedPungsHand(pc.TileSetDef, hand, wc))
+	},
+	SmallFourWinds: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.SmallFourWinds(hand)
+	},
+	BigFourWinds: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.BigFourWinds(hand)
+	},
+	MeldedHand: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.MeldedHand(hand)
+	},
+	HalfMeldedHand: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.HalfMeldedHand(hand)
+	},
+	HeavenlyHand: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.HeavenlyHand(ps, hand, wc)
+	},
+	EarthlyHand: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.EarthlyHand(g, ps, wc)
+	},
+	FlowerTiles: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+
+		// It is replaced by seat flowers
+		if g.gs.Meta.FlowerRules.SeatFlower {
+			return 0
+		}
+
+		return pc.FlowerTiles(hand)
+	},
+	LastTileDraw: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.LastTileDraw(wc)
+	},
+	LastDiscard: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.LastDiscard(wc)
+	},
+	AfterAKong: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.AfterAKong(wc)
+	},
+	RobbingTheKong: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.RobbingTheKong(wc)
+	},
+	KongOnDiscard: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.KongOnDiscard(wc)
+	},
+	SingleWait: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.SingleWait(g.gs.Meta.TileSetDef, hand, wc)
+	},
+	SelfDrawn: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.SelfDrawn(wc)
+	},
+	DeclareReadyHand: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.DeclareReadyHand(wc)
+	},
+	MeldedKong: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.MeldedKong(hand)
+	},
+	ConcealedKong: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.ConcealedKong(hand)
+	},
+	ConcealedHand: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.ConcealedHand(hand)
+	},
+	Banker: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.Banker(g, ps, wc)
+	},
+	BankerStreak: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.BankerStreak(g, ps, wc)
+	},
+	SeatWind: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.SeatWind(ps.Wind, hand)
+	},
+	PrevailingWind: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.PrevailingWind(g.gs.Meta.PrevailingWind, hand)
+	},
+	DragonPung: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.DragonPung(hand)
+	},
+	SeatFlower: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+
+		if !g.gs.Meta.FlowerRules.SeatFlower {
+			return 0
+		}
+
+		return pc.SeatFlower(ps.Wind, hand)
+	},
+	FlowerKong: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+
+		if !g.gs.Meta.FlowerRules.FlowerKong {
+			return 0
+		}
+
+		return pc.FlowerKong(hand)
+	},
+	EightImmortals: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.EightImmortals(wc)
+	},
+	SevenRobOne: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+		return pc.SevenRobOne(wc)
+	},
+	NoWilds: func(pc *PointCalculator, g *Game, ps *PlayerState, hand *Hand, wc *WinContext) int {
+
+		// It makes sense only if there are wild tiles
+		if g.gs.Meta.TileSetDef.GetWild() == nil {
+			return 0
+		}
+
+		return pc.NoWilds(wc)
+	},
+}
+
+// Calculate evaluates the winning hand against all rules of calculator. The hand must be
+// the full winning hand which includes the winning tile, and wc describes how it was won.
+func (pc *PointCalculator) Calculate(g *Game, ps *PlayerState, hand *Hand, wc *WinContext) WinnerResult {
+
+	result := WinnerResult{
+		Points:     0,
+		Conditions: make(map[PointType]int),
+	}
+
+	types := make([]PointType, 0, len(pc.Rules))
+	for pt := range pc.Rules {
+		types = append(types, pt)
+	}
+
+	for _, pt := range sortPointTypes(types) {
+
+		checker, ok := pointCheckers[pt]
+		if !ok {
+			continue
+		}
+
+		// Hand is not completed for winning with flowers
+		if wc.IsFlowerWin() && !flowerWinPointTypes[pt] {
+			continue
+		}
+
+		p := checker(pc, g, ps, hand, wc)
+		if p <= 0 {
+			continue
+		}
+
+		result.Conditions[pt] = p
+	}
+
+	// Only the highest pattern counts
+	result.Conditions = pc.ApplyRuleRelations(result.Conditions)
+
+	for _, p := range result.Conditions {
+		result.Points += p
+	}
+
+	return result
+}
+
+// ApplyRuleRelations removes patterns which are implied by or exclusive with other patterns.
+// Of exclusive patterns with equal points, the one which lists the other in Excludes counts,
+// and the one which is defined first counts if both of them list each other.
+func (pc *PointCalculator) ApplyRuleRelations(conditions map[PointType]int) map[PointType]int {
+
+	removed := make(map[PointType]bool)
+
+	sorted := make([]PointType, 0, len(conditions))
+	for pt := range conditions {
+		sorted = append(sorted, pt)
+	}
+
+	sortPointTypes(sorted)
+
+	// Patterns which are part of other patterns
+	for _, pt := range sorted {
+		for _, implied := range pc.Rules[pt].Implies {
+			removed[implied] = true
+		}
+	}
+
+	// Patterns with higher points go first, then the one which is defined first
+	var types []PointType
+	for _, pt := range sorted {
+		if !removed[pt] {
+			types = append(types, pt)
+		}
+	}
+
+	sort.SliceStable(types, func(i, j int) bool {
+		return conditions[types[i]] > conditions[types[j]]
+	})
+
+	for i, pt := range types {
+
+		if removed[pt] {
+			continue
+		}
+
+		for _, other := range types[i+1:] {
+
+			if removed[other] || !pc.isExclusive(pt, other) {
+				continue
+			}
+
+			// Other pattern excludes this one explicitly
+			if conditions[pt] == conditions[other] && !pc.excludes(pt, other) {
+				removed[pt] = true
+				break
+			}
+
+			removed[other] = true
+		}
+	}
+
+	results := make(map[PointType]int)
+	for pt, p := range conditions {
+		if !removed[pt] {
+			results[pt] = p
+		}
+	}
+
+	return results
+}
+
+// sortPointTypes sorts point types in place, so patterns are always checked in the same order
+func sortPointTypes(types []PointType) []PointType {
+
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+
+	return types
+}
+
+func (pc *PointCalculator) isExclusive(a PointType, b PointType) bool {
+	return pc.excludes(a, b) || pc.excludes(b, a)
 }
 
-func (pc *PointCalculator) MinimalPoints(hand *Hand) {
-	// 實現判斷平胡（自摸）的邏輯
+// excludes checks if rule of a lists b in Excludes
+func (pc *PointCalculator) excludes(a PointType, b PointType) bool {
+
+	for _, pt := range pc.Rules[a].Excludes {
+		if pt == b {
+			return true
+		}
+	}
+
+	return false
 }
 
-func (pc *PointCalculator) DeclareReadyHand(ps *PlayerState) int {
+func (pc *PointCalculator) MinimalPoints(hand *Hand) int {
+
+	// 平胡：無花、無字、全順子且非自摸
+
+	if len(hand.Flowers) > 0 || len(hand.Draw) > 0 {
+		return 0
+	}
+
+	if len(hand.Triplet) > 0 || len(hand.Kong.Concealed) > 0 || len(hand.Kong.Open) > 0 {
+		return 0
+	}
+
+	// Wild tiles may stand for pungs
+	if pc.hasWildTiles(hand) {
+		return 0
+	}
+
+	// No honors
+	results := CountBySuits(hand.Tiles)
+	for suit := range results {
+		if GetResolverRules(pc.TileSetDef, suit) != SuitedTileRule {
+			return 0
+		}
+	}
+
+	segments := ResolveTileSegmentationsWithTileSet(pc.TileSetDef, hand.Tiles)
+	for _, s := range segments {
+
+		// Eyes
+		if len(s) == 2 {
+			continue
+		}
+
+		if IsTriplet(s) {
+			return 0
+		}
+	}
+
+	return pc.Rules[MinimalPoints].Point
+}
+
+func (pc *PointCalculator) DeclareReadyHand(wc *WinContext) int {
 
 	// 宣告聽牌
-	if !ps.IsReadyHand {
+	if !wc.IsReadyHand {
 		return 0
 	}
 
@@ -163,7 +530,7 @@ func (pc *PointCalculator) HalfFlush(hand *Hand) int {
 	}
 
 	var foundSuit TileSuit
-	for suit, _ := range results {
+	for suit := range results {
 		if suit == TileSuitDragon || suit == TileSuitWind {
 			continue
 		}
@@ -195,7 +562,7 @@ func (pc *PointCalculator) FullFlush(hand *Hand) int {
 		return 0
 	}
 
-	for suit, _ := range results {
+	for suit := range results {
 		// No dragon and wind
 		if suit == TileSuitDragon || suit == TileSuitWind {
 			return 0
@@ -247,8 +614,12 @@ func (pc *PointCalculator) AllHonorsHand(hand *Hand) int {
 	tiles = append(tiles, hand.Kong.Open...)
 	tiles = append(tiles, hand.Kong.Concealed...)
 
+	for _, s := range hand.Straight {
+		tiles = append(tiles, s...)
+	}
+
 	results := CountBySuits(tiles)
-	for suit, _ := range results {
+	for suit := range results {
 		if suit != TileSuitDragon && suit != TileSuitWind {
 			return 0
 		}
@@ -290,15 +661,66 @@ func (pc *PointCalculator) BigThreeDragons(hand *Hand) int {
 	return pc.Rules[BigThreeDragons].Point
 }
 
+// hasWildTiles checks if concealed tiles of hand have wild tiles, segmentations of them are not certain
+func (pc *PointCalculator) hasWildTiles(hand *Hand) bool {
+	_, wilds := SplitWildTiles(pc.TileSetDef, hand.Tiles)
+	return len(wilds) > 0
+}
+
+// getConcealedPungsHand moves pung which was completed by discarded winning tile to melded pungs, it is not concealed (明刻)
+func getConcealedPungsHand(tileSetDef *TileSetDef, hand *Hand, wc *WinContext) *Hand {
+
+	if wc == nil || wc.IsSelfDrawn || wc.WinningTile == "" {
+		return hand
+	}
+
+	// Concealed pungs are not counted with wild tiles
+	if _, wilds := SplitWildTiles(tileSetDef, hand.Tiles); len(wilds) > 0 {
+		return hand
+	}
+
+	segments := ResolveTileSegmentationsWithTileSet(tileSetDef, hand.Tiles)
+	for _, s := range segments {
+
+		if !IsTriplet(s) || s[0] != wc.WinningTile {
+			continue
+		}
+
+		h := hand.Clone()
+		h.Tiles = make([]string, 0, len(hand.Tiles))
+
+		n := 0
+		for _, t := range hand.Tiles {
+			if n < 3 && t == wc.WinningTile {
+				n++
+				continue
+			}
+
+			h.Tiles = append(h.Tiles, t)
+		}
+
+		h.Triplet = append(h.Triplet, wc.WinningTile)
+
+		return h
+	}
+
+	return hand
+}
+
 func (pc *PointCalculator) ThreeConcealedPungs(hand *Hand) int {
 
 	// 實現判斷三暗刻的邏輯
 
+	// Sets which wild tiles make up are not certain
+	if pc.hasWildTiles(hand) {
+		return 0
+	}
+
+	// Melded pungs are not concealed
 	count := 0
-	count += len(hand.Triplet)
 	count += len(hand.Kong.Concealed)
 
-	segments := ResolveTileSegmentations(hand.Tiles)
+	segments := ResolveTileSegmentationsWithTileSet(pc.TileSetDef, hand.Tiles)
 	for _, s := range segments {
 		if IsTriplet(s) {
 			count++
@@ -316,11 +738,16 @@ func (pc *PointCalculator) FourConcealedPungs(hand *Hand) int {
 
 	// 實現判斷四暗刻的邏輯
 
+	// Sets which wild tiles make up are not certain
+	if pc.hasWildTiles(hand) {
+		return 0
+	}
+
+	// Melded pungs are not concealed
 	count := 0
-	count += len(hand.Triplet)
 	count += len(hand.Kong.Concealed)
 
-	segments := ResolveTileSegmentations(hand.Tiles)
+	segments := ResolveTileSegmentationsWithTileSet(pc.TileSetDef, hand.Tiles)
 
 	for _, s := range segments {
 		if IsTriplet(s) {
@@ -339,11 +766,16 @@ func (pc *PointCalculator) FiveConcealedPungs(hand *Hand) int {
 
 	// 實現判斷五暗刻的邏輯
 
+	// Sets which wild tiles make up are not certain
+	if pc.hasWildTiles(hand) {
+		return 0
+	}
+
+	// Melded pungs are not concealed
 	count := 0
-	count += len(hand.Triplet)
 	count += len(hand.Kong.Concealed)
 
-	segments := ResolveTileSegmentations(hand.Tiles)
+	segments := ResolveTileSegmentationsWithTileSet(pc.TileSetDef, hand.Tiles)
 
 	for _, s := range segments {
 		if IsTriplet(s) {
@@ -468,7 +900,7 @@ func (pc *PointCalculator) HalfMeldedHand(hand *Hand) int {
 	return pc.Rules[HalfMeldedHand].Point
 }
 
-func (pc *PointCalculator) HeavenlyHand(g *Game, ps *PlayerState, hand *Hand) int {
+func (pc *PointCalculator) HeavenlyHand(ps *PlayerState, hand *Hand, wc *WinContext) int {
 
 	// Not banker
 	if !ps.IsBanker {
@@ -476,42 +908,46 @@ func (pc *PointCalculator) HeavenlyHand(g *Game, ps *PlayerState, hand *Hand) in
 	}
 
 	// Not draw by self
-	if len(ps.Hand.Draw) == 0 {
+	if !wc.IsSelfDrawn {
 		return 0
 	}
 
-	if len(ps.Hand.Kong.Open) > 0 || len(ps.Hand.Kong.Concealed) > 0 {
+	if len(hand.Kong.Open) > 0 || len(hand.Kong.Concealed) > 0 {
 		return 0
 	}
 
 	// Not the first tile
-	if len(g.gs.Status.DiscardArea) != 0 {
+	if wc.Turn != 1 {
 		return 0
 	}
 
 	return pc.Rules[HeavenlyHand].Point
 }
 
-// TODO: TBD
-func (pc *PointCalculator) EarthlyHand(g *Game, ps *PlayerState, hand *Hand) int {
+func (pc *PointCalculator) EarthlyHand(g *Game, ps *PlayerState, wc *WinContext) int {
 
 	// Should not be banker
 	if ps.IsBanker {
 		return 0
 	}
 
+	// Not draw by self
+	if !wc.IsSelfDrawn {
+		return 0
+	}
+
+	// Not the first round
+	if wc.Turn > len(g.gs.Players) {
+		return 0
+	}
+
 	// No one do special action
 	for _, p := range g.gs.Players {
-		if len(p.Hand.Kong.Open) > 0 || len(p.Hand.Triplet) > 0 || len(p.Hand.Straight) > 0 {
+		if len(p.Hand.Kong.Open) > 0 || len(p.Hand.Kong.Concealed) > 0 || len(p.Hand.Triplet) > 0 || len(p.Hand.Straight) > 0 {
 			return 0
 		}
 	}
 
-	// Not draw by self
-	if len(ps.Hand.Draw) == 0 {
-		return 0
-	}
-
 	return pc.Rules[EarthlyHand].Point
 }
 
@@ -520,42 +956,87 @@ func (pc *PointCalculator) FlowerTiles(hand *Hand) int {
 	return len(hand.Flowers)
 }
 
-func (pc *PointCalculator) AfterAKong(hand *Hand) {
-	// 實現判斷槓上開花的邏輯
+func (pc *PointCalculator) AfterAKong(wc *WinContext) int {
+
+	// 槓上開花
+
+	if !wc.IsSelfDrawn || !wc.IsAfterKong {
+		return 0
+	}
+
+	return pc.Rules[AfterAKong].Point
 }
 
-func (pc *PointCalculator) LastTileDraw(g *Game, hand *Hand) int {
+func (pc *PointCalculator) LastTileDraw(wc *WinContext) int {
 
 	// 海底撈月
 
-	if len(hand.Draw) == 0 {
+	if !wc.IsSelfDrawn || !wc.IsLastTile {
 		return 0
 	}
 
-	if len(g.gs.Meta.Tiles) != g.gs.Status.CurrentTileSetPosition {
+	return pc.Rules[LastTileDraw].Point
+}
+
+func (pc *PointCalculator) LastDiscard(wc *WinContext) int {
+
+	// 河底撈魚
+
+	if wc.IsSelfDrawn || !wc.IsLastTile {
 		return 0
 	}
 
-	return pc.Rules[LastTileDraw].Point
+	return pc.Rules[LastDiscard].Point
 }
 
-func (pc *PointCalculator) RobbingTheKong(hand *Hand) {
-	// 實現判斷搶槓胡的邏輯
+func (pc *PointCalculator) RobbingTheKong(wc *WinContext) int {
+
+	// 搶槓胡
+
+	if !wc.IsRobbedKong {
+		return 0
+	}
+
+	return pc.Rules[RobbingTheKong].Point
 }
 
-func (pc *PointCalculator) KongOnDiscard(hand *Hand) {
-	// 實現判斷杠上炮的邏輯
+func (pc *PointCalculator) KongOnDiscard(wc *WinContext) int {
+
+	// 杠上炮
+
+	if wc.IsSelfDrawn || !wc.IsAfterKong {
+		return 0
+	}
+
+	return pc.Rules[KongOnDiscard].Point
 }
 
-func (pc *PointCalculator) SingleWait(hand *Hand) {
-	// 實現判斷獨聽的邏輯
+func (pc *PointCalculator) SingleWait(tileSetDef *TileSetDef, hand *Hand, wc *WinContext) int {
+
+	// 獨聽
+
+	if tileSetDef == nil {
+		tileSetDef = StandardSetOfTiles
+	}
+
+	// Take winning tile off
+	tiles, n := RemoveTiles(hand.Tiles, []string{wc.WinningTile})
+	if n != 1 {
+		return 0
+	}
+
+	if len(FigureWinningTiles(tileSetDef, tiles)) != 1 {
+		return 0
+	}
+
+	return pc.Rules[SingleWait].Point
 }
 
-func (pc *PointCalculator) SelfDrawn(hand *Hand) int {
+func (pc *PointCalculator) SelfDrawn(wc *WinContext) int {
 
 	// 自摸
 
-	if len(hand.Draw) == 0 {
+	if !wc.IsSelfDrawn {
 		return 0
 	}
 
@@ -582,3 +1063,120 @@ func (pc *PointCalculator) ConcealedHand(hand *Hand) int {
 
 	return pc.Rules[ConcealedHand].Point
 }
+
+func (pc *PointCalculator) Banker(g *Game, ps *PlayerState, wc *WinContext) int {
+
+	// 莊家：莊家胡牌或莊家放槍
+
+	if !g.isBankerInvolved(ps, wc) {
+		return 0
+	}
+
+	return pc.Rules[Banker].Point
+}
+
+func (pc *PointCalculator) BankerStreak(g *Game, ps *PlayerState, wc *WinContext) int {
+
+	// 連莊拉莊：連 n 拉 n
+
+	if !g.isBankerInvolved(ps, wc) {
+		return 0
+	}
+
+	return pc.Rules[BankerStreak].Point * g.gs.Meta.WinningStreak
+}
+
+// BankerPoints returns extra points that banker has to pay or get paid with winning streak
+func (pc *PointCalculator) BankerPoints(winningStreak int) int {
+	return pc.Rules[Banker].Point + pc.Rules[BankerStreak].Point*winningStreak
+}
+
+func (pc *PointCalculator) SeatWind(wind string, hand *Hand) int {
+
+	// 門風：門風牌刻子或槓
+
+	if wind == "" || !hand.HasPungOrKong(wind) {
+		return 0
+	}
+
+	return pc.Rules[SeatWind].Point
+}
+
+func (pc *PointCalculator) PrevailingWind(wind string, hand *Hand) int {
+
+	// 圈風：圈風牌刻子或槓
+
+	if wind == "" || !hand.HasPungOrKong(wind) {
+		return 0
+	}
+
+	return pc.Rules[PrevailingWind].Point
+}
+
+func (pc *PointCalculator) DragonPung(hand *Hand) int {
+
+	// 三元牌：每組中發白刻子或槓
+
+	count := 0
+	for _, t := range []string{"D1", "D2", "D3"} {
+		if hand.HasPungOrKong(t) {
+			count++
+		}
+	}
+
+	return pc.Rules[DragonPung].Point * count
+}
+
+func (pc *PointCalculator) SeatFlower(wind string, hand *Hand) int {
+
+	// 正花：與門風相同的花牌及季節牌
+
+	count := 0
+	for _, t := range FigureSeatFlowers(wind) {
+		if ContainsTile(hand.Flowers, t) {
+			count++
+		}
+	}
+
+	return pc.Rules[SeatFlower].Point * count
+}
+
+func (pc *PointCalculator) FlowerKong(hand *Hand) int {
+
+	// 花槓：集滿梅蘭竹菊或春夏秋冬
+
+	return pc.Rules[FlowerKong].Point * CountFlowerKongs(hand.Flowers)
+}
+
+func (pc *PointCalculator) EightImmortals(wc *WinContext) int {
+
+	// 八仙過海
+
+	if !wc.IsEightImmortals {
+		return 0
+	}
+
+	return pc.Rules[EightImmortals].Point
+}
+
+func (pc *PointCalculator) SevenRobOne(wc *WinContext) int {
+
+	// 七搶一
+
+	if !wc.IsSevenRobOne {
+		return 0
+	}
+
+	return pc.Rules[SevenRobOne].Point
+}
+
+func (pc *PointCalculator) NoWilds(wc *WinContext) int {
+
+	// 無百搭
+
+	if len(wc.WildTiles) > 0 {
+		return 0
+	}
+
+	return pc.Rules[NoWilds].Point
+}
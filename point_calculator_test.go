@@ -799,3 +799,747 @@ func Test_PointCalculator_LittleThreeDragons(t *testing.T) {
 		}
 	}
 }
+
+func Test_PointCalculator_Calculate(t *testing.T) {
+
+	cases := []struct {
+		Points     int
+		Conditions map[PointType]int
+		Context    *WinContext
+		Hand       *Hand
+	}{
+		{
+			// 門前清 + 自摸 + 花牌 + 獨聽
+			4,
+			map[PointType]int{
+				ConcealedHand: 1,
+				SelfDrawn:     1,
+				FlowerTiles:   1,
+				SingleWait:    1,
+			},
+			&WinContext{
+				Winner:       1,
+				WinningTile:  "B1",
+				SourcePlayer: 1,
+				IsSelfDrawn:  true,
+				Turn:         6,
+			},
+			&Hand{
+				Flowers:  []string{"F1"},
+				Triplet:  []string{},
+				Straight: [][]string{},
+				Kong: Kong{
+					Open:      []string{},
+					Concealed: []string{},
+				},
+				Tiles: []string{
+					"T1", "T2", "T3",
+					"T4", "T5", "T6",
+					"W1", "W2", "W3",
+					"W4", "W5", "W6",
+					"B7", "B8", "B9",
+					"B1", "B1",
+				},
+				Draw: []string{"B1"},
+			},
+		},
+		{
+			// 碰碰胡 + 混一色 + 門風
+			9,
+			map[PointType]int{
+				PungHand:  4,
+				HalfFlush: 4,
+				SeatWind:  1,
+			},
+			&WinContext{
+				Winner:       1,
+				WinningTile:  "I3",
+				SourcePlayer: 2,
+				Turn:         10,
+			},
+			&Hand{
+				Flowers:  []string{},
+				Triplet:  []string{"T1", "I2", "T9"},
+				Straight: [][]string{},
+				Kong: Kong{
+					Open:      []string{},
+					Concealed: []string{},
+				},
+				Tiles: []string{"T6", "T6", "T6", "T3", "T3", "I3", "I3", "I3"},
+				Draw:  []string{},
+			},
+		},
+	}
+
+	opts := NewOptions()
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+	g := NewGame(opts)
+	g.InitializeGame()
+
+	pc := NewPointCalculator(StandardRules)
+
+	for i, c := range cases {
+
+		ps := g.GetPlayer(1)
+
+		result := pc.Calculate(g, ps, c.Hand, c.Context)
+		assert.Equal(t, c.Points, result.Points, i)
+		assert.Equal(t, c.Conditions, result.Conditions, i)
+	}
+}
+
+func Test_PointCalculator_WinContext(t *testing.T) {
+
+	cases := []struct {
+		Conditions []PointType
+		Context    *WinContext
+	}{
+		{
+			[]PointType{SelfDrawn, AfterAKong},
+			&WinContext{IsSelfDrawn: true, IsAfterKong: true},
+		},
+		{
+			[]PointType{KongOnDiscard},
+			&WinContext{IsAfterKong: true},
+		},
+		{
+			[]PointType{SelfDrawn, LastTileDraw},
+			&WinContext{IsSelfDrawn: true, IsLastTile: true},
+		},
+		{
+			[]PointType{LastDiscard},
+			&WinContext{IsLastTile: true},
+		},
+		{
+			[]PointType{RobbingTheKong, DeclareReadyHand},
+			&WinContext{IsRobbedKong: true, IsReadyHand: true},
+		},
+	}
+
+	pc := NewPointCalculator(StandardRules)
+
+	checkers := map[PointType]func(wc *WinContext) int{
+		SelfDrawn:        pc.SelfDrawn,
+		AfterAKong:       pc.AfterAKong,
+		KongOnDiscard:    pc.KongOnDiscard,
+		LastTileDraw:     pc.LastTileDraw,
+		LastDiscard:      pc.LastDiscard,
+		RobbingTheKong:   pc.RobbingTheKong,
+		DeclareReadyHand: pc.DeclareReadyHand,
+	}
+
+	for i, c := range cases {
+		for pt, checker := range checkers {
+
+			p := checker(c.Context)
+
+			found := false
+			for _, expected := range c.Conditions {
+				if expected == pt {
+					found = true
+				}
+			}
+
+			if found {
+				assert.NotZero(t, p, i, pt)
+			} else {
+				assert.Zero(t, p, i, pt)
+			}
+		}
+	}
+}
+
+func Test_PointCalculator_SingleWait(t *testing.T) {
+
+	cases := []struct {
+		Answer      bool
+		WinningTile string
+		Tiles       []string
+	}{
+		{
+			// 邊張
+			true,
+			"W3",
+			[]string{"W1", "W2", "W3", "T4", "T5", "T6", "B1", "B1"},
+		},
+		{
+			// 單吊
+			true,
+			"D1",
+			[]string{"W1", "W2", "W3", "T4", "T5", "T6", "D1", "D1"},
+		},
+		{
+			// 兩面聽
+			false,
+			"W4",
+			[]string{"W2", "W3", "W4", "T4", "T5", "T6", "B1", "B1"},
+		},
+	}
+
+	pc := NewPointCalculator(StandardRules)
+
+	for i, c := range cases {
+
+		hand := NewHand()
+		hand.Tiles = c.Tiles
+
+		wc := &WinContext{
+			WinningTile: c.WinningTile,
+		}
+
+		if c.Answer {
+			assert.NotZero(t, pc.SingleWait(StandardSetOfTiles, hand, wc), i)
+		} else {
+			assert.Zero(t, pc.SingleWait(StandardSetOfTiles, hand, wc), i)
+		}
+	}
+}
+
+func Test_PointCalculator_Banker(t *testing.T) {
+
+	cases := []struct {
+		Winner  int
+		Points  int
+		Context *WinContext
+	}{
+		// Banker won
+		{0, 5, &WinContext{Winner: 0, SourcePlayer: 2}},
+		// Banker dealt in
+		{1, 5, &WinContext{Winner: 1, SourcePlayer: 0}},
+		// Self-drawn by other player
+		{1, 0, &WinContext{Winner: 1, SourcePlayer: 1, IsSelfDrawn: true}},
+		// Banker is not involved
+		{1, 0, &WinContext{Winner: 1, SourcePlayer: 2}},
+	}
+
+	opts := NewOptions()
+	opts.WinningStreak = 2
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+	g := NewGame(opts)
+	g.InitializeGame()
+
+	pc := NewPointCalculator(StandardRules)
+
+	for i, c := range cases {
+		ps := g.GetPlayer(c.Winner)
+		points := pc.Banker(g, ps, c.Context) + pc.BankerStreak(g, ps, c.Context)
+		assert.Equal(t, c.Points, points, i)
+	}
+}
+
+func Test_PointCalculator_Winds(t *testing.T) {
+
+	hand := &Hand{
+		Flowers:  []string{},
+		Triplet:  []string{"I2"},
+		Straight: [][]string{},
+		Kong: Kong{
+			Open:      []string{},
+			Concealed: []string{"D1"},
+		},
+		Tiles: []string{"I1", "I1", "I1", "D2", "D2", "D2", "T1", "T2", "T3", "D3", "D3"},
+		Draw:  []string{},
+	}
+
+	pc := NewPointCalculator(StandardRules)
+
+	// 門風
+	assert.NotZero(t, pc.SeatWind("I1", hand))
+	assert.NotZero(t, pc.SeatWind("I2", hand))
+	assert.Zero(t, pc.SeatWind("I3", hand))
+
+	// 圈風
+	assert.NotZero(t, pc.PrevailingWind("I2", hand))
+	assert.Zero(t, pc.PrevailingWind("I4", hand))
+
+	// 中、發 but no 白
+	assert.Equal(t, 2, pc.DragonPung(hand))
+}
+
+func Test_PointCalculator_Calculate_Winds(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+	opts.Banker = 3
+	opts.PrevailingWind = "I2"
+
+	g := NewGame(opts)
+	g.InitializeGame()
+
+	hand := &Hand{
+		Flowers:  []string{},
+		Triplet:  []string{"I2", "W5"},
+		Straight: [][]string{},
+		Kong: Kong{
+			Open:      []string{},
+			Concealed: []string{},
+		},
+		Tiles: []string{"W1", "W2", "W3", "T1", "T2", "T3", "B1", "B2", "B3", "D3", "D3"},
+		Draw:  []string{},
+	}
+
+	// Seat of player 0 is south
+	ps := g.GetPlayer(0)
+	assert.Equal(t, "I2", ps.Wind)
+
+	wc := &WinContext{
+		Winner:       0,
+		WinningTile:  "D3",
+		SourcePlayer: 1,
+		Turn:         10,
+	}
+
+	pc := NewPointCalculator(StandardRules)
+	result := pc.Calculate(g, ps, hand, wc)
+
+	assert.Equal(t, 1, result.Conditions[SeatWind])
+	assert.Equal(t, 1, result.Conditions[PrevailingWind])
+	assert.Zero(t, result.Conditions[DragonPung])
+}
+
+func Test_PointCalculator_ApplyRuleRelations(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+	g := NewGame(opts)
+	g.InitializeGame()
+
+	discarded := &WinContext{
+		Winner:       2,
+		WinningTile:  "W3",
+		SourcePlayer: 1,
+		Turn:         10,
+	}
+
+	cases := []struct {
+		Included []PointType
+		Excluded []PointType
+		Context  *WinContext
+		Hand     *Hand
+	}{
+		{
+			// 大四喜
+			[]PointType{BigFourWinds},
+			[]PointType{SmallFourWinds, SeatWind, PrevailingWind},
+			discarded,
+			&Hand{
+				Flowers:  []string{},
+				Triplet:  []string{"I1", "I2", "I3"},
+				Straight: [][]string{},
+				Kong: Kong{
+					Open:      []string{},
+					Concealed: []string{},
+				},
+				Tiles: []string{"I4", "I4", "I4", "W1", "W2", "W3", "T5", "T5"},
+				Draw:  []string{},
+			},
+		},
+		{
+			// 清一色
+			[]PointType{FullFlush},
+			[]PointType{HalfFlush},
+			discarded,
+			&Hand{
+				Flowers:  []string{},
+				Triplet:  []string{},
+				Straight: [][]string{{"W1", "W2", "W3"}},
+				Kong: Kong{
+					Open:      []string{},
+					Concealed: []string{},
+				},
+				Tiles: []string{
+					"W4", "W5", "W6",
+					"W7", "W8", "W9",
+					"W2", "W3", "W4",
+					"W6", "W7", "W8",
+					"W5", "W5",
+				},
+				Draw: []string{},
+			},
+		},
+		{
+			// 五暗刻
+			[]PointType{FiveConcealedPungs, SelfDrawn},
+			[]PointType{FourConcealedPungs, ThreeConcealedPungs},
+			&WinContext{
+				Winner:       2,
+				WinningTile:  "B9",
+				SourcePlayer: 2,
+				IsSelfDrawn:  true,
+				Turn:         10,
+			},
+			&Hand{
+				Flowers:  []string{},
+				Triplet:  []string{},
+				Straight: [][]string{},
+				Kong: Kong{
+					Open:      []string{},
+					Concealed: []string{},
+				},
+				Tiles: []string{
+					"T1", "T1", "T1",
+					"T4", "T4", "T4",
+					"W7", "W7", "W7",
+					"B2", "B2", "B2",
+					"B9", "B9", "B9",
+					"D1", "D1",
+				},
+				Draw: []string{"B9"},
+			},
+		},
+	}
+
+	pc := NewPointCalculator(StandardRules)
+
+	for i, c := range cases {
+
+		result := pc.Calculate(g, g.GetPlayer(2), c.Hand, c.Context)
+
+		for _, pt := range c.Included {
+			assert.Contains(t, result.Conditions, pt, i)
+		}
+
+		for _, pt := range c.Excluded {
+			assert.NotContains(t, result.Conditions, pt, i)
+		}
+	}
+}
+
+func Test_PointCalculator_ApplyRuleRelations_EqualPoints(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+	g := NewGame(opts)
+	g.InitializeGame()
+
+	// 碰碰胡 + 混一色
+	hand := &Hand{
+		Flowers:  []string{},
+		Triplet:  []string{"W1", "I2"},
+		Straight: [][]string{},
+		Kong: Kong{
+			Open:      []string{},
+			Concealed: []string{},
+		},
+		Tiles: []string{"W3", "W3", "W3", "W5", "W5", "W5", "W7", "W7", "W7", "D1", "D1"},
+		Draw:  []string{},
+	}
+
+	wc := &WinContext{
+		Winner:       2,
+		WinningTile:  "D1",
+		SourcePlayer: 1,
+		Turn:         10,
+	}
+
+	cases := []struct {
+		Expected  PointType
+		PungHand  []PointType
+		HalfFlush []PointType
+	}{
+		// Pattern which excludes the other counts
+		{PungHand, []PointType{HalfFlush}, nil},
+		{HalfFlush, nil, []PointType{PungHand}},
+
+		// Pattern which is defined first counts
+		{PungHand, []PointType{HalfFlush}, []PointType{PungHand}},
+	}
+
+	for i, c := range cases {
+
+		pc := NewPointCalculator(map[PointType]PointRule{
+			PungHand:  {Type: PungHand, Point: 4, Excludes: c.PungHand},
+			HalfFlush: {Type: HalfFlush, Point: 4, Excludes: c.HalfFlush},
+		})
+
+		// Result doesn't depend on order of maps
+		for j := 0; j < 20; j++ {
+			result := pc.Calculate(g, g.GetPlayer(2), hand, wc)
+			assert.Equal(t, map[PointType]int{c.Expected: 4}, result.Conditions, i)
+		}
+	}
+}
+
+func Test_PointCalculator_Calculate_BigThreeDragons(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+	g := NewGame(opts)
+	g.InitializeGame()
+
+	hand := &Hand{
+		Flowers:  []string{},
+		Triplet:  []string{"D1", "D2"},
+		Straight: [][]string{{"W1", "W2", "W3"}},
+		Kong: Kong{
+			Open:      []string{},
+			Concealed: []string{},
+		},
+		Tiles: []string{"D3", "D3", "D3", "W7", "W8", "W9", "T5", "T5"},
+		Draw:  []string{},
+	}
+
+	wc := &WinContext{
+		Winner:       2,
+		WinningTile:  "W9",
+		SourcePlayer: 1,
+		Turn:         10,
+	}
+
+	pc := NewPointCalculator(StandardRules)
+	result := pc.Calculate(g, g.GetPlayer(2), hand, wc)
+
+	assert.Equal(t, map[PointType]int{BigThreeDragons: 8}, result.Conditions)
+	assert.Equal(t, 8, result.Points)
+}
+
+func Test_PointCalculator_ConcealedPungs_Melded(t *testing.T) {
+
+	// Melded pungs are not concealed
+	hand := &Hand{
+		Flowers:  []string{},
+		Triplet:  []string{"T6"},
+		Straight: [][]string{},
+		Kong: Kong{
+			Open:      []string{},
+			Concealed: []string{},
+		},
+		Tiles: []string{
+			"B9", "B9", "B9",
+			"W8", "W8", "W8",
+			"W5", "W6", "W7",
+			"T1", "T2", "T3",
+			"B1", "B1",
+		},
+		Draw: []string{"B1"},
+	}
+
+	pc := NewPointCalculator(StandardRules)
+	assert.Zero(t, pc.ThreeConcealedPungs(hand))
+
+	// Concealed kong counts
+	hand.Triplet = []string{}
+	hand.Kong.Concealed = []string{"T6"}
+	assert.NotZero(t, pc.ThreeConcealedPungs(hand))
+}
+
+func Test_PointCalculator_Calculate_ConcealedPungs(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+	g := NewGame(opts)
+	g.InitializeGame()
+
+	newHand := func() *Hand {
+		return &Hand{
+			Flowers:  []string{},
+			Triplet:  []string{},
+			Straight: [][]string{},
+			Kong: Kong{
+				Open:      []string{},
+				Concealed: []string{},
+			},
+			Tiles: []string{
+				"T6", "T6", "T6",
+				"B9", "B9", "B9",
+				"W8", "W8", "W8",
+				"W5", "W6", "W7",
+				"T1", "T2", "T3",
+				"B1", "B1",
+			},
+			Draw: []string{},
+		}
+	}
+
+	pc := NewPointCalculator(StandardRules)
+
+	// Pung which was completed by discarded tile is melded
+	result := pc.Calculate(g, g.GetPlayer(2), newHand(), &WinContext{
+		Winner:       2,
+		WinningTile:  "W8",
+		SourcePlayer: 1,
+		Turn:         10,
+	})
+
+	assert.NotContains(t, result.Conditions, ThreeConcealedPungs)
+
+	// Discarded tile completes eyes, pungs are concealed
+	result = pc.Calculate(g, g.GetPlayer(2), newHand(), &WinContext{
+		Winner:       2,
+		WinningTile:  "B1",
+		SourcePlayer: 1,
+		Turn:         10,
+	})
+
+	assert.Contains(t, result.Conditions, ThreeConcealedPungs)
+
+	// Self-drawn
+	hand := newHand()
+	hand.Draw = []string{"W8"}
+
+	result = pc.Calculate(g, g.GetPlayer(2), hand, &WinContext{
+		Winner:       2,
+		WinningTile:  "W8",
+		SourcePlayer: 2,
+		IsSelfDrawn:  true,
+		Turn:         10,
+	})
+
+	assert.Contains(t, result.Conditions, ThreeConcealedPungs)
+}
+
+func Test_PointCalculator_MinimalPoints(t *testing.T) {
+
+	newHand := func() *Hand {
+		return &Hand{
+			Flowers:  []string{},
+			Triplet:  []string{},
+			Straight: [][]string{{"W1", "W2", "W3"}},
+			Kong: Kong{
+				Open:      []string{},
+				Concealed: []string{},
+			},
+			Tiles: []string{
+				"T1", "T2", "T3",
+				"T4", "T5", "T6",
+				"W4", "W5", "W6",
+				"B7", "B8", "B9",
+				"B1", "B1",
+			},
+			Draw: []string{},
+		}
+	}
+
+	pc := NewPointCalculator(StandardRules)
+
+	assert.NotZero(t, pc.MinimalPoints(newHand()))
+
+	// Flowers
+	hand := newHand()
+	hand.Flowers = []string{"F1"}
+	assert.Zero(t, pc.MinimalPoints(hand))
+
+	// Self-drawn
+	hand = newHand()
+	hand.Draw = []string{"B1"}
+	assert.Zero(t, pc.MinimalPoints(hand))
+
+	// Melded pung
+	hand = newHand()
+	hand.Straight = [][]string{}
+	hand.Triplet = []string{"W1"}
+	assert.Zero(t, pc.MinimalPoints(hand))
+
+	// Pung in hand
+	hand = newHand()
+	hand.Tiles[3], hand.Tiles[4], hand.Tiles[5] = "T9", "T9", "T9"
+	assert.Zero(t, pc.MinimalPoints(hand))
+
+	// Honor tiles
+	hand = newHand()
+	hand.Tiles[12], hand.Tiles[13] = "D1", "D1"
+	assert.Zero(t, pc.MinimalPoints(hand))
+}
+
+func Test_PointCalculator_Calculate_HalfMeldedHand(t *testing.T) {
+
+	assert.Equal(t, HalfMeldedHand, StandardRules[HalfMeldedHand].Type)
+
+	opts := NewOptions()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+	g := NewGame(opts)
+	g.InitializeGame()
+
+	hand := &Hand{
+		Flowers:  []string{},
+		Triplet:  []string{"W1", "T9"},
+		Straight: [][]string{{"W2", "W3", "W4"}, {"T3", "T4", "T5"}, {"B5", "B6", "B7"}},
+		Kong: Kong{
+			Open:      []string{},
+			Concealed: []string{},
+		},
+		Tiles: []string{"B2", "B2"},
+		Draw:  []string{"B2"},
+	}
+
+	wc := &WinContext{
+		Winner:       2,
+		WinningTile:  "B2",
+		SourcePlayer: 2,
+		IsSelfDrawn:  true,
+		Turn:         10,
+	}
+
+	pc := NewPointCalculator(StandardRules)
+	result := pc.Calculate(g, g.GetPlayer(2), hand, wc)
+
+	assert.Contains(t, result.Conditions, HalfMeldedHand)
+	assert.NotContains(t, result.Conditions, MeldedHand)
+}
+
+func Test_PointCalculator_Calculate_CustomSuits(t *testing.T) {
+
+	def := &TileSetDef{
+		Suits: append(append([]TileDef{}, StandardSetOfTiles.Suits...), TileDef{"X", TileKindNumbered, 9, 4, nil}),
+	}
+
+	opts := NewOptions()
+	opts.TileSetDef = def
+	opts.Tiles = NewTileSet(def)
+
+	g := NewGame(opts)
+	g.InitializeGame()
+
+	pc := g.getPointCalculator()
+
+	newHand := func(tiles []string) *Hand {
+		h := NewHand()
+		h.Tiles = tiles
+		return h
+	}
+
+	wc := &WinContext{
+		Winner:       2,
+		WinningTile:  "B2",
+		SourcePlayer: 1,
+		Turn:         10,
+	}
+
+	// Straights of custom suit
+	hand := newHand([]string{
+		"X1", "X2", "X3",
+		"X4", "X5", "X6",
+		"X7", "X8", "X9",
+		"W1", "W2", "W3",
+		"T4", "T5", "T6",
+		"B2", "B2",
+	})
+
+	result := pc.Calculate(g, g.GetPlayer(2), hand, wc)
+	assert.Contains(t, result.Conditions, MinimalPoints)
+
+	// Pungs of custom suit
+	hand = newHand([]string{
+		"X1", "X1", "X1",
+		"X5", "X5", "X5",
+		"X9", "X9", "X9",
+		"W1", "W2", "W3",
+		"T4", "T5", "T6",
+		"B2", "B2",
+	})
+
+	result = pc.Calculate(g, g.GetPlayer(2), hand, wc)
+	assert.NotContains(t, result.Conditions, MinimalPoints)
+	assert.Contains(t, result.Conditions, ThreeConcealedPungs)
+
+	// Discarded tile completes pung
+	wc.WinningTile = "X9"
+	result = pc.Calculate(g, g.GetPlayer(2), hand, wc)
+	assert.NotContains(t, result.Conditions, ThreeConcealedPungs)
+}
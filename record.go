@@ -0,0 +1,368 @@
+package foursquare
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+)
+
+var (
+	ErrUnsupportedRecordVersion = errors.New("record: unsupported version")
+	ErrUnknownRuleSet           = errors.New("record: unknown rule set")
+	ErrInvalidRecordAction      = errors.New("record: invalid action")
+	ErrReplayFinished           = errors.New("record: no more actions")
+	ErrRecordMismatch           = errors.New("record: result doesn't match")
+)
+
+// Version of record format, it changes only if old records can not be read any more
+const RecordVersion = 1
+
+const (
+	RecordAction_Ready     = "ready"
+	RecordAction_Act       = "act"       // After drawing a tile: win, kong or discard
+	RecordAction_Discard   = "discard"   // Discarded tile
+	RecordAction_ReadyHand = "readyhand" // Discarded tile and declared ready hand
+	RecordAction_React     = "react"     // Reaction to discarded tile or kong, player is -1 if everyone passed
+)
+
+// Record is a game record (牌譜) which has everything to play a hand again, it doesn't depend on GameState
+type Record struct {
+	Version   int    `json:"version"`
+	GameID    string `json:"game_id"`
+	CreatedAt int64  `json:"created_at"`
+
+	RuleSet        string      `json:"ruleset"`
+	Rules          *RuleSet    `json:"rules,omitempty"` // Rule set which was played, name only refers to preset in old records
+	TileSetDef     *TileSetDef `json:"tileset_def"`
+	HandTileCount  int         `json:"handtile_count"`
+	PlayerCount    int         `json:"player_count"`
+	WinningStreak  int         `json:"winning_streak"`
+	BasePoint      int         `json:"base_point"`
+	PointValue     int         `json:"point_value"`
+	Banker         int         `json:"banker"`
+	PrevailingWind string      `json:"prevailing_wind"`
+
+	BankerStaysOnWin  bool                    `json:"banker_stays_on_win"`
+	BankerStaysOnDraw bool                    `json:"banker_stays_on_draw"`
+	PointRules        map[PointType]PointRule `json:"point_rules,omitempty"`
+	FlowerRules       FlowerRules             `json:"flower_rules"`
+
+	Dices       []int         `json:"dices"`
+	Wall        []string      `json:"wall"`
+	InitialHand map[int]*Hand `json:"initial_hand,omitempty"`
+
+	Seats   []RecordSeat   `json:"seats"`
+	Actions []RecordAction `json:"actions"`
+	Result  *Result        `json:"result,omitempty"`
+}
+
+type RecordSeat struct {
+	Idx  int    `json:"idx"`
+	Wind string `json:"wind"`
+	Name string `json:"name,omitempty"` // Name of player, it is up to platform
+}
+
+type RecordAction struct {
+	Type   string   `json:"type"`
+	Player int      `json:"player"`
+	Action string   `json:"action,omitempty"`
+	Tile   string   `json:"tile,omitempty"` // Discarded tile, or tile which player wins, kongs or claims
+	Tiles  []string `json:"tiles,omitempty"`
+}
+
+// startCommand keeps action of command, it is recorded once command changes state
+func (g *Game) startCommand(a RecordAction) {
+
+	// Commands which engine runs by itself will be run again in replay
+	if g.eventDepth > 0 {
+		return
+	}
+
+	a.Tiles = append([]string{}, a.Tiles...)
+	if len(a.Tiles) == 0 {
+		a.Tiles = nil
+	}
+
+	g.command = &a
+}
+
+// recordCommand adds action of running command to state, so it is saved along with changes of command
+func (g *Game) recordCommand() {
+
+	if g.command == nil {
+		return
+	}
+
+	g.gs.Actions = append(g.gs.Actions, *g.command)
+	g.command = nil
+}
+
+// getRecordedTile returns tile which action of current status wins, kongs or claims
+func (g *Game) getRecordedTile(a RecordAction) string {
+
+	switch a.Type {
+	case RecordAction_Act:
+
+		ps := g.GetPlayer(a.Player)
+		if ps == nil || len(ps.Hand.Draw) == 0 || (a.Action != "win" && a.Action != "kong") {
+			return ""
+		}
+
+		return ps.Hand.Draw[0]
+
+	case RecordAction_React:
+
+		if a.Player == -1 || a.Action == "" {
+			return ""
+		}
+
+		// Robbing the kong
+		if g.gs.Status.AddedKongTile != "" {
+			return g.gs.Status.AddedKongTile
+		}
+
+		if len(g.gs.Status.DiscardArea) == 0 {
+			return ""
+		}
+
+		return g.gs.Status.DiscardArea[len(g.gs.Status.DiscardArea)-1]
+	}
+
+	return ""
+}
+
+// GetRecord returns record of commands since game was created, actions are kept in state so a loaded game has them as well
+func (g *Game) GetRecord() *Record {
+
+	gs := g.gs
+	rs := g.getRuleSet()
+
+	r := &Record{
+		Version:        RecordVersion,
+		GameID:         gs.GameID,
+		CreatedAt:      gs.CreatedAt,
+		RuleSet:        rs.Name,
+		Rules:          rs,
+		TileSetDef:     gs.Meta.TileSetDef,
+		HandTileCount:  gs.Meta.HandTileCount,
+		PlayerCount:    gs.Meta.PlayerCount,
+		WinningStreak:  gs.Meta.WinningStreak,
+		BasePoint:      gs.Meta.BasePoint,
+		PointValue:     gs.Meta.PointValue,
+		Banker:         gs.Meta.Banker,
+		PrevailingWind: gs.Meta.PrevailingWind,
+
+		BankerStaysOnWin:  gs.Meta.BankerStaysOnWin,
+		BankerStaysOnDraw: gs.Meta.BankerStaysOnDraw,
+		PointRules:        gs.Meta.PointRules,
+		FlowerRules:       gs.Meta.FlowerRules,
+
+		Dices:       append([]int{}, gs.Meta.Dices...),
+		Wall:        append([]string{}, gs.Meta.Tiles...),
+		InitialHand: g.initialHand,
+		Seats:       make([]RecordSeat, 0, len(gs.Players)),
+		Actions:     append([]RecordAction{}, gs.Actions...),
+		Result:      gs.Result,
+	}
+
+	for _, ps := range gs.Players {
+		r.Seats = append(r.Seats, RecordSeat{
+			Idx:  ps.Idx,
+			Wind: ps.Wind,
+		})
+	}
+
+	return r
+}
+
+// WriteRecord writes record as JSON
+func WriteRecord(w io.Writer, r *Record) error {
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(r)
+}
+
+// ReadRecord reads record which was written by WriteRecord
+func ReadRecord(reader io.Reader) (*Record, error) {
+
+	var r Record
+
+	err := json.NewDecoder(reader).Decode(&r)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Version < 1 || r.Version > RecordVersion {
+		return nil, ErrUnsupportedRecordVersion
+	}
+
+	return &r, nil
+}
+
+// NewOptionsFromRecord returns options to play the hand of record again
+func NewOptionsFromRecord(r *Record) (*Options, error) {
+
+	// Old records have name of preset only
+	if r.Rules == nil {
+
+		rs, ok := FindRuleSet(r.RuleSet)
+		if !ok {
+			return nil, ErrUnknownRuleSet
+		}
+
+		opts := NewOptionsWithRuleSet(rs)
+		applyRecordToOptions(opts, r)
+
+		return opts, nil
+	}
+
+	opts := NewOptionsWithRuleSet(r.Rules)
+	opts.BankerStaysOnWin = r.BankerStaysOnWin
+	opts.BankerStaysOnDraw = r.BankerStaysOnDraw
+	opts.PointRules = r.PointRules
+	opts.FlowerRules = r.FlowerRules
+	applyRecordToOptions(opts, r)
+
+	return opts, nil
+}
+
+func applyRecordToOptions(opts *Options, r *Record) {
+
+	opts.TileSetDef = r.TileSetDef
+	opts.HandTileCount = r.HandTileCount
+	opts.PlayerCount = r.PlayerCount
+	opts.WinningStreak = r.WinningStreak
+	opts.BasePoint = r.BasePoint
+	opts.PointValue = r.PointValue
+	opts.Banker = r.Banker
+	opts.PrevailingWind = r.PrevailingWind
+	opts.Dices = append([]int{}, r.Dices...)
+	opts.Tiles = append([]string{}, r.Wall...)
+	opts.InitialHand = r.InitialHand
+}
+
+// Replay plays actions of record one by one
+type Replay struct {
+	record *Record
+	game   *Game
+	pos    int
+}
+
+func NewReplay(r *Record) (*Replay, error) {
+
+	opts, err := NewOptionsFromRecord(r)
+	if err != nil {
+		return nil, err
+	}
+
+	g := NewGame(opts)
+
+	err = g.StartGame()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Replay{
+		record: r,
+		game:   g,
+	}, nil
+}
+
+func (rp *Replay) GetGame() *Game {
+	return rp.game
+}
+
+// Position returns number of actions which have been played
+func (rp *Replay) Position() int {
+	return rp.pos
+}
+
+// Step plays the next action
+func (rp *Replay) Step() error {
+
+	if rp.pos >= len(rp.record.Actions) {
+		return ErrReplayFinished
+	}
+
+	a := rp.record.Actions[rp.pos]
+	g := rp.game
+
+	// Actions of current player must belong to the player who made them
+	if a.Type != RecordAction_Ready && a.Type != RecordAction_React && a.Player != g.gs.Status.CurrentPlayer {
+		return ErrInvalidRecordAction
+	}
+
+	// Tile which was won, konged or claimed must be the same
+	if a.Tile != "" && (a.Type == RecordAction_Act || a.Type == RecordAction_React) && a.Tile != g.getRecordedTile(a) {
+		return ErrInvalidRecordAction
+	}
+
+	var err error
+
+	switch a.Type {
+	case RecordAction_Ready:
+		err = g.Ready()
+	case RecordAction_Act:
+		err = g.Act(a.Action)
+	case RecordAction_Discard:
+		err = g.DiscardTile(a.Tile)
+	case RecordAction_ReadyHand:
+		err = g.ReadyHand(a.Tile)
+	case RecordAction_React:
+		tiles := a.Tiles
+		if tiles == nil {
+			tiles = []string{}
+		}
+		err = g.React(a.Player, a.Action, tiles)
+	default:
+		return ErrInvalidRecordAction
+	}
+
+	if err != nil {
+		return err
+	}
+
+	rp.pos++
+
+	return nil
+}
+
+// Run plays all actions which are left
+func (rp *Replay) Run() error {
+
+	for rp.pos < len(rp.record.Actions) {
+		err := rp.Step()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Verify plays all actions, then checks if game ends with the same result as record
+func (rp *Replay) Verify() error {
+
+	err := rp.Run()
+	if err != nil {
+		return err
+	}
+
+	expected, err := json.Marshal(rp.record.Result)
+	if err != nil {
+		return err
+	}
+
+	actual, err := json.Marshal(rp.game.gs.Result)
+	if err != nil {
+		return err
+	}
+
+	if string(expected) != string(actual) {
+		return ErrRecordMismatch
+	}
+
+	return nil
+}
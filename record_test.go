@@ -0,0 +1,207 @@
+package foursquare
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func playRecordedGame(t *testing.T, opts *Options, seed int64) *Game {
+
+	table := newTestTable(opts, seed)
+
+	_, err := table.Run()
+	assert.Nil(t, err)
+
+	return table.GetGame()
+}
+
+func Test_Record_Replay(t *testing.T) {
+
+	for _, rs := range []*RuleSet{TaiwanRuleSet, HongKongRuleSet, ThreePlayerRuleSet} {
+
+		g := playRecordedGame(t, NewOptionsWithRuleSet(rs), 1)
+
+		var buf bytes.Buffer
+		assert.Nil(t, WriteRecord(&buf, g.GetRecord()))
+
+		r, err := ReadRecord(&buf)
+		assert.Nil(t, err)
+		assert.Equal(t, rs.Name, r.RuleSet)
+		assert.Equal(t, NewOptionsWithRuleSet(rs).PlayerCount, len(r.Seats))
+		assert.Equal(t, RecordAction_Ready, r.Actions[0].Type)
+
+		rp, err := NewReplay(r)
+		assert.Nil(t, err)
+		assert.Nil(t, rp.Verify(), rs.Name)
+
+		// Hands of players are the same as well
+		assert.Equal(t, g.GetState().Players, rp.GetGame().GetState().Players)
+		assert.Equal(t, g.GetState().Status, rp.GetGame().GetState().Status)
+
+		assert.Equal(t, ErrReplayFinished, rp.Step())
+	}
+}
+
+func Test_Record_CustomGame(t *testing.T) {
+
+	// Rule set which is not a preset
+	rs := *TaiwanRuleSet
+	rs.Name = "custom"
+	rs.ChowFrom = []int{1, 2, 3}
+
+	rules := make(map[PointType]PointRule)
+	for pt, rule := range StandardRules {
+		rules[pt] = rule
+	}
+	rules[SelfDrawn] = PointRule{Type: SelfDrawn, Point: 5}
+
+	opts := NewOptionsWithRuleSet(&rs)
+	opts.BankerStaysOnWin = false
+	opts.PointRules = rules
+	opts.FlowerRules = FlowerRules{SeatFlower: true, FlowerKong: true}
+
+	g := playRecordedGame(t, opts, 1)
+
+	var buf bytes.Buffer
+	assert.Nil(t, WriteRecord(&buf, g.GetRecord()))
+
+	record, err := ReadRecord(&buf)
+	assert.Nil(t, err)
+
+	// Tiles which were won, konged or claimed
+	claims := 0
+	for _, a := range record.Actions {
+
+		isClaim := a.Type == RecordAction_React && a.Player != -1
+		isWinOrKong := a.Type == RecordAction_Act && (a.Action == "win" || a.Action == "kong")
+		if isClaim || isWinOrKong {
+			assert.NotEmpty(t, a.Tile)
+			claims++
+		}
+	}
+
+	rp, err := NewReplay(record)
+	assert.Nil(t, err)
+	assert.Nil(t, rp.Verify())
+
+	// Game was replayed with the same rules
+	meta := rp.GetGame().GetState().Meta
+	assert.Equal(t, g.GetState().Meta, meta)
+	assert.Equal(t, 5, meta.PointRules[SelfDrawn].Point)
+	assert.Equal(t, "custom", meta.RuleSet.Name)
+	assert.Equal(t, []int{1, 2, 3}, meta.RuleSet.ChowFrom)
+
+	assert.Greater(t, claims, 0)
+}
+
+func Test_Record_StepThrough(t *testing.T) {
+
+	g := playRecordedGame(t, nil, 1)
+	r := g.GetRecord()
+
+	rp, err := NewReplay(r)
+	assert.Nil(t, err)
+	assert.Equal(t, GetGameEventSymbols(GameEvent_WaitForReady), rp.GetGame().GetState().Status.CurrentEvent)
+
+	assert.Nil(t, rp.Step())
+	assert.Equal(t, 1, rp.Position())
+
+	// Wall after dealing
+	gs := rp.GetGame().GetState()
+	assert.Equal(t, r.Wall, gs.Meta.Tiles)
+	assert.Equal(t, r.Dices, gs.Meta.Dices)
+
+	for rp.Position() < len(r.Actions) {
+		assert.Nil(t, rp.Step())
+	}
+
+	assert.Equal(t, GetGameEventSymbols(GameEvent_GameClosed), rp.GetGame().GetState().Status.CurrentEvent)
+}
+
+func Test_Record_LoadGame(t *testing.T) {
+
+	for name, store := range newTestGameStores(t) {
+
+		opts := NewOptions()
+		opts.Store = store
+
+		table := newTestTable(opts, 3)
+
+		assert.Nil(t, table.GetGame().StartGame(), name)
+		for i := 0; i < 10; i++ {
+			assert.Nil(t, table.Step(), name)
+		}
+
+		// Game which was loaded still has actions before it was saved
+		g, err := LoadGame(store, table.GetGame().GetState().GameID)
+		assert.Nil(t, err, name)
+		assert.Equal(t, table.GetGame().GetRecord().Actions, g.GetRecord().Actions, name)
+
+		table = NewTable(g)
+		for i := 0; i < opts.PlayerCount; i++ {
+			table.Sit(i, NewBot())
+		}
+
+		_, err = table.Run()
+		assert.Nil(t, err, name)
+
+		rp, err := NewReplay(g.GetRecord())
+		assert.Nil(t, err, name)
+		assert.Nil(t, rp.Verify(), name)
+	}
+}
+
+func Test_Record_Mismatch(t *testing.T) {
+
+	g := playRecordedGame(t, nil, 2)
+
+	// Somebody changed the result
+	r := g.GetRecord()
+	r.Result = &Result{IsDrawnGame: !r.Result.IsDrawnGame}
+
+	rp, err := NewReplay(r)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrRecordMismatch, rp.Verify())
+
+	// Action of another player
+	r = g.GetRecord()
+	for i, a := range r.Actions {
+		if a.Type == RecordAction_Discard {
+			r.Actions[i].Player = (a.Player + 1) % 4
+			break
+		}
+	}
+
+	rp, err = NewReplay(r)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrInvalidRecordAction, rp.Verify())
+
+	// Claimed tile was changed
+	r = g.GetRecord()
+	for i, a := range r.Actions {
+		if a.Tile != "" && (a.Type == RecordAction_Act || a.Type == RecordAction_React) {
+			r.Actions[i].Tile = "X9"
+			break
+		}
+	}
+
+	rp, err = NewReplay(r)
+	assert.Nil(t, err)
+	assert.Equal(t, ErrInvalidRecordAction, rp.Verify())
+}
+
+func Test_ReadRecord_Errors(t *testing.T) {
+
+	_, err := ReadRecord(strings.NewReader(`{"version":2}`))
+	assert.Equal(t, ErrUnsupportedRecordVersion, err)
+
+	_, err = ReadRecord(strings.NewReader(`{"version":`))
+	assert.NotNil(t, err)
+
+	// Old record without rules
+	_, err = NewReplay(&Record{Version: 1, RuleSet: "nothing"})
+	assert.Equal(t, ErrUnknownRuleSet, err)
+}
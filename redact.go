@@ -0,0 +1,53 @@
+package foursquare
+
+// HiddenTile takes place of tiles which viewer is not able to see
+const HiddenTile = "??"
+
+// Redact returns a copy of game state for player, concealed tiles of other players and the wall are hidden.
+// Hands are revealed after game was closed.
+func (gs *GameState) Redact(viewerIdx int) *GameState {
+
+	state := gs.Clone()
+	if state == nil {
+		return nil
+	}
+
+	state.Meta.Tiles = hideTiles(state.Meta.Tiles)
+
+	// Actions tell tiles of concealed kongs
+	state.Actions = nil
+
+	if state.Result != nil {
+		return state
+	}
+
+	for i := range state.Players {
+
+		ps := &state.Players[i]
+		if ps.Idx == viewerIdx {
+			continue
+		}
+
+		ps.ResetAllowedActions()
+
+		if ps.Hand == nil {
+			continue
+		}
+
+		ps.Hand.Tiles = hideTiles(ps.Hand.Tiles)
+		ps.Hand.Draw = hideTiles(ps.Hand.Draw)
+		ps.Hand.Kong.Concealed = hideTiles(ps.Hand.Kong.Concealed)
+	}
+
+	return state
+}
+
+func hideTiles(tiles []string) []string {
+
+	hidden := make([]string, len(tiles))
+	for i := range hidden {
+		hidden[i] = HiddenTile
+	}
+
+	return hidden
+}
@@ -0,0 +1,45 @@
+package foursquare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_GameState_Redact(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+	g := NewGame(opts)
+	assert.Nil(t, g.StartGame())
+	assert.Nil(t, g.Ready())
+
+	gs := g.GetState()
+	gs.Players[2].Hand.Kong.Concealed = []string{"D1"}
+
+	state := gs.Redact(1)
+
+	// Original state is untouched
+	assert.Equal(t, "W1", gs.Players[0].Hand.Tiles[0])
+	assert.Equal(t, "W1", gs.Meta.Tiles[0])
+
+	assert.Equal(t, len(gs.Meta.Tiles), len(state.Meta.Tiles))
+	assert.Equal(t, HiddenTile, state.Meta.Tiles[0])
+	assert.Equal(t, g.getRemainingTileCount(), state.GetRemainingTileCount())
+
+	// Actions are kept for record only
+	assert.NotEmpty(t, gs.Actions)
+	assert.Nil(t, state.Actions)
+
+	// Banker
+	assert.Equal(t, 17, len(state.Players[0].Hand.Tiles))
+	assert.Equal(t, HiddenTile, state.Players[0].Hand.Tiles[0])
+	assert.Equal(t, []string{HiddenTile}, state.Players[0].Hand.Draw)
+	assert.Equal(t, 0, len(state.Players[0].AllowedActions))
+	assert.Equal(t, []string{HiddenTile}, state.Players[2].Hand.Kong.Concealed)
+
+	// Viewer
+	assert.Equal(t, gs.Players[1].Hand.Tiles, state.Players[1].Hand.Tiles)
+}
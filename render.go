@@ -0,0 +1,225 @@
+package foursquare
+
+import (
+	"fmt"
+	"strings"
+)
+
+type RenderOptions struct {
+	ViewerIdx int                 // Concealed tiles of other players are hidden, -1 shows everything
+	TileName  func(string) string // TileGlyph is used if it is nil
+}
+
+func NewRenderOptions() *RenderOptions {
+	return &RenderOptions{
+		ViewerIdx: -1,
+		TileName:  TileGlyph,
+	}
+}
+
+// RenderState renders game state as human-readable text
+func RenderState(gs *GameState, opts *RenderOptions) string {
+
+	if opts == nil {
+		opts = NewRenderOptions()
+	}
+
+	r := &stateRenderer{
+		gs:   gs,
+		opts: opts,
+	}
+
+	return r.render()
+}
+
+// RenderState renders game state of the game, all tiles are visible
+func (g *Game) RenderState() string {
+	return RenderState(g.gs, nil)
+}
+
+type stateRenderer struct {
+	gs   *GameState
+	opts *RenderOptions
+	sb   strings.Builder
+}
+
+func (r *stateRenderer) name(tile string) string {
+
+	if r.opts.TileName == nil {
+		return TileGlyph(tile)
+	}
+
+	return r.opts.TileName(tile)
+}
+
+func (r *stateRenderer) tiles(tiles []string) string {
+
+	sorted := append([]string{}, tiles...)
+	SortTiles(sorted)
+
+	return strings.Join(TileNames(sorted, r.name), " ")
+}
+
+func (r *stateRenderer) line(format string, args ...interface{}) {
+	r.sb.WriteString(fmt.Sprintf(format, args...))
+	r.sb.WriteString("\n")
+}
+
+func (r *stateRenderer) render() string {
+
+	gs := r.gs
+
+	r.line("Event: %s  Turn: %d  Wall: %d  Prevailing: %s", gs.Status.CurrentEvent, gs.Status.Turn, gs.GetRemainingTileCount(), r.windName(gs.Meta.PrevailingWind))
+
+	for i := range gs.Players {
+		r.renderPlayer(&gs.Players[i])
+	}
+
+	r.line("Discards: %s", strings.Join(TileNames(gs.Status.DiscardArea, r.name), " "))
+
+	if gs.Result != nil {
+		r.renderResult(gs.Result)
+	}
+
+	return r.sb.String()
+}
+
+func (r *stateRenderer) windName(wind string) string {
+
+	if wind == "" {
+		return "-"
+	}
+
+	return r.name(wind)
+}
+
+func (r *stateRenderer) renderPlayer(ps *PlayerState) {
+
+	marks := make([]string, 0)
+	if ps.IsBanker {
+		marks = append(marks, "Banker")
+	}
+
+	if ps.Idx == r.gs.Status.CurrentPlayer {
+		marks = append(marks, "Current")
+	}
+
+	if ps.IsReadyHand {
+		marks = append(marks, "Ready")
+	}
+
+	header := fmt.Sprintf("Seat %d [%s]", ps.Idx, r.windName(ps.Wind))
+	if len(marks) > 0 {
+		header += " " + strings.Join(marks, ", ")
+	}
+
+	r.line(header)
+
+	h := ps.Hand
+	if h == nil {
+		return
+	}
+
+	isVisible := r.opts.ViewerIdx == -1 || r.opts.ViewerIdx == ps.Idx
+
+	// Concealed tiles
+	if isVisible {
+		tiles, _ := RemoveTiles(h.Tiles, h.Draw)
+		r.line("  Hand:    %s", r.tiles(tiles))
+
+		if len(h.Draw) > 0 {
+			r.line("  Draw:    %s", r.tiles(h.Draw))
+		}
+	} else {
+		r.line("  Hand:    %d tiles", len(h.Tiles))
+	}
+
+	// Exposed melds
+	melds := make([]string, 0)
+	for _, s := range h.Straight {
+		melds = append(melds, "["+r.tiles(s)+"]")
+	}
+
+	for _, t := range h.Triplet {
+		melds = append(melds, "["+r.tiles([]string{t, t, t})+"]")
+	}
+
+	for _, t := range h.Kong.Open {
+		melds = append(melds, "["+r.tiles([]string{t, t, t, t})+"]")
+	}
+
+	for _, t := range h.Kong.Concealed {
+		if isVisible {
+			melds = append(melds, "("+r.tiles([]string{t, t, t, t})+")")
+		} else {
+			melds = append(melds, "(concealed kong)")
+		}
+	}
+
+	if len(melds) > 0 {
+		r.line("  Melds:   %s", strings.Join(melds, " "))
+	}
+
+	if len(h.Flowers) > 0 {
+		r.line("  Flowers: %s", r.tiles(h.Flowers))
+	}
+
+	if isVisible && len(ps.AllowedActions) > 0 {
+		r.line("  Actions: %s", r.actions(ps.AllowedActions))
+	}
+}
+
+func (r *stateRenderer) actions(actions []*Action) string {
+
+	names := make([]string, 0, len(actions))
+	for _, a := range actions {
+
+		name := a.Name
+
+		switch {
+		case len(a.Candidates) > 0:
+			candidates := make([]string, 0, len(a.Candidates))
+			for _, c := range a.Candidates {
+				candidates = append(candidates, r.tiles(c))
+			}
+
+			name += "(" + strings.Join(candidates, " | ") + ")"
+
+		case len(a.ReadyHandCandidates) > 0:
+			candidates := make([]string, 0, len(a.ReadyHandCandidates))
+			for _, c := range a.ReadyHandCandidates {
+				candidates = append(candidates, r.name(c.DiscardedTile)+" → "+r.tiles(c.TargetTiles))
+			}
+
+			name += "(" + strings.Join(candidates, " | ") + ")"
+		}
+
+		names = append(names, name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func (r *stateRenderer) renderResult(result *Result) {
+
+	if result.IsDrawnGame {
+		r.line("Result: drawn game")
+		return
+	}
+
+	r.line("Result: %s from seat %d", r.name(result.WinningTile), result.DiscardingPlayer)
+
+	for i := range r.gs.Players {
+
+		winner, ok := result.Winners[i]
+		if !ok {
+			continue
+		}
+
+		r.line("  Seat %d wins %d points", i, winner.Points)
+	}
+
+	for i := range r.gs.Players {
+		r.line("  Seat %d: %+d", i, result.Deltas[i])
+	}
+}
@@ -0,0 +1,62 @@
+package foursquare
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RenderState(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+	g := NewGame(opts)
+	assert.Nil(t, g.StartGame())
+	assert.Nil(t, g.Ready())
+
+	ro := NewRenderOptions()
+	ro.TileName = TileChineseName
+
+	text := RenderState(g.GetState(), ro)
+	lines := strings.Split(text, "\n")
+
+	assert.Equal(t, "Event: WaitForPlayerToDiscardTile  Turn: 1  Wall: 79  Prevailing: 東", lines[0])
+	assert.Equal(t, "Seat 0 [東] Banker, Current", lines[1])
+	assert.Contains(t, text, "  Draw:    八筒\n")
+	assert.Contains(t, text, "  Actions: discard, readyhand(一筒 → 二筒 五筒 八筒 | ")
+	assert.Contains(t, text, "Seat 3 [北]\n")
+
+	// Other players are hidden
+	ro.ViewerIdx = 1
+	text = RenderState(g.GetState(), ro)
+	assert.Contains(t, text, "  Hand:    17 tiles\n")
+	assert.NotContains(t, text, "Draw:")
+	assert.NotContains(t, text, "Actions:")
+}
+
+func Test_RenderState_Melds(t *testing.T) {
+
+	gs := NewGameState()
+	gs.Players = []PlayerState{
+		{
+			Idx:  0,
+			Wind: "I1",
+			Hand: MustParseHand("123w [789b] [555t] (2222d) 12f"),
+		},
+	}
+
+	ro := NewRenderOptions()
+	ro.TileName = func(tile string) string { return tile }
+
+	text := RenderState(gs, ro)
+	assert.Contains(t, text, "  Hand:    W1 W2 W3\n")
+	assert.Contains(t, text, "  Melds:   [B7 B8 B9] [T5 T5 T5] (D2 D2 D2 D2)\n")
+	assert.Contains(t, text, "  Flowers: F1 F2\n")
+
+	ro.ViewerIdx = 2
+	text = RenderState(gs, ro)
+	assert.Contains(t, text, "(concealed kong)")
+}
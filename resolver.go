@@ -37,17 +37,20 @@ func NewResolvedState() *ResolvedState {
 	}
 }
 
+// GetResolverRules returns rules by kind of suit, tiles of suits which are not numbered make pungs and pairs only
+func GetResolverRules(tileSetDef *TileSetDef, suit TileSuit) *ResolverRules {
+
+	if tileSetDef.GetSuitKind(suit) == TileKindNumbered {
+		return SuitedTileRule
+	}
+
+	return HonorTileRule
+}
+
 func ResolveSuitTiles(tileSetDef *TileSetDef, suit TileSuit, tiles []string, hasEyes bool) *ResolvedState {
 
 	// Determine rules for suit
-	var rules *ResolverRules
-
-	switch suit {
-	case TileSuitWan, TileSuitTong, TileSuitBamboo:
-		rules = SuitedTileRule
-	case TileSuitWind, TileSuitDragon:
-		rules = HonorTileRule
-	}
+	rules := GetResolverRules(tileSetDef, suit)
 
 	state := NewResolvedState()
 
@@ -79,6 +82,11 @@ func ResolveSuitTiles(tileSetDef *TileSetDef, suit TileSuit, tiles []string, has
 
 func Resolve(tileSetDef *TileSetDef, tiles []string) *ResolvedState {
 
+	// Wild tiles stand for tiles of any suit, so tiles can't be resolved suit by suit
+	if tileSetDef.GetWild() != nil {
+		return resolveWithWilds(tileSetDef, tiles)
+	}
+
 	groups := MakeSuitGroups(tiles)
 
 	var states []*ResolvedState
@@ -141,7 +149,15 @@ func Resolve(tileSetDef *TileSetDef, tiles []string) *ResolvedState {
 	return state
 }
 
+// ResolveTileSegmentations returns sets and eyes which tiles of standard tile set make
 func ResolveTileSegmentations(tiles []string) [][]string {
+	return ResolveTileSegmentationsWithTileSet(StandardSetOfTiles, tiles)
+}
+
+// ResolveTileSegmentationsWithTileSet returns sets and eyes which tiles make, suits are played by their kinds in tile set.
+// Tiles of suit which is not numbered make pungs and pairs only. Wild tiles don't stand for other tiles here,
+// they are played as tiles of their own suit.
+func ResolveTileSegmentationsWithTileSet(tileSetDef *TileSetDef, tiles []string) [][]string {
 
 	segments := make([][]string, 0)
 
@@ -149,14 +165,7 @@ func ResolveTileSegmentations(tiles []string) [][]string {
 	for suit, g := range groups {
 
 		// Determine rules for suit
-		var rules *ResolverRules
-
-		switch suit {
-		case TileSuitWan, TileSuitTong, TileSuitBamboo:
-			rules = SuitedTileRule
-		case TileSuitWind, TileSuitDragon:
-			rules = HonorTileRule
-		}
+		rules := GetResolverRules(tileSetDef, suit)
 
 		hasEyes := false
 		if len(g)%3 != 0 {
@@ -171,6 +180,24 @@ func ResolveTileSegmentations(tiles []string) [][]string {
 	return segments
 }
 
+// FigureWinningTiles returns all kinds of tile which are able to complete tiles
+func FigureWinningTiles(tileSetDef *TileSetDef, tiles []string) []string {
+
+	candidates := make([]string, 0)
+
+	for _, t := range figurePlayableTiles(tileSetDef) {
+
+		ts := append(append([]string{}, tiles...), t)
+
+		state := Resolve(tileSetDef, ts)
+		if state.IsWin {
+			candidates = append(candidates, t)
+		}
+	}
+
+	return candidates
+}
+
 func FigureDiscardCandidatesForReadyHand(tileSetDef *TileSetDef, tiles []string) []*DiscardCandidate {
 
 	candidates := make([]*DiscardCandidate, 0)
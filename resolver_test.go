@@ -226,3 +226,66 @@ func Test_Resolver_ResolveTileSegmentations(t *testing.T) {
 		assert.ElementsMatch(t, c.Answer, segments, c.Tiles)
 	}
 }
+
+func Test_Resolver_FigureWinningTiles_CustomSuits(t *testing.T) {
+
+	// Numbered suit with five numbers, and dragons are played as numbered tiles
+	def := &TileSetDef{
+		Suits: []TileDef{
+			{"C", TileKindNumbered, 5, 4, nil},
+			{TileSuitDragon, TileKindNumbered, 3, 4, nil},
+		},
+	}
+
+	tiles := []string{"C1", "C2", "C3", "C4", "D1", "D2", "D3"}
+	assert.ElementsMatch(t, []string{"C1", "C4"}, FigureWinningTiles(def, tiles))
+
+	// Dragons make only pungs in standard set
+	assert.ElementsMatch(t, []string{}, FigureWinningTiles(StandardSetOfTiles, []string{"W1", "W2", "W3", "W4", "D1", "D2", "D3"}))
+}
+
+func Test_Resolver_ResolveTileSegmentations_CustomSuits(t *testing.T) {
+
+	// Tile set doesn't know suit X, tiles of it make pungs and pairs only
+	segments := ResolveTileSegmentations([]string{"W1", "W1", "W1", "T2", "T2", "T2", "S3", "S3", "S3", "X1", "X1"})
+	assert.ElementsMatch(t, [][]string{
+		{"W1", "W1", "W1"},
+		{"T2", "T2", "T2"},
+		{"S3", "S3", "S3"},
+		{"X1", "X1"},
+	}, segments)
+
+	def := &TileSetDef{
+		Suits: append(append([]TileDef{}, StandardSetOfTiles.Suits...), TileDef{"X", TileKindNumbered, 9, 4, nil}),
+	}
+
+	segments = ResolveTileSegmentationsWithTileSet(def, []string{"X1", "X2", "X3", "X5", "X5", "I1", "I1", "I1"})
+	assert.ElementsMatch(t, [][]string{
+		{"X1", "X2", "X3"},
+		{"X5", "X5"},
+		{"I1", "I1", "I1"},
+	}, segments)
+}
+
+func Test_Resolver_Resolve_SuitWithoutKind(t *testing.T) {
+
+	// Suit which doesn't tell kind is played as honor tiles
+	def := &TileSetDef{
+		Suits: append(append([]TileDef{}, StandardSetOfTiles.Suits...), TileDef{Suit: "A", Numbers: 4, Count: 1}),
+	}
+
+	assert.Equal(t, HonorTileRule, GetResolverRules(def, "A"))
+
+	state := Resolve(def, []string{"W1", "W2", "W3", "A1", "A1"})
+	assert.True(t, state.IsWin)
+	assert.Equal(t, []string{"A1"}, state.Eyes)
+
+	state = Resolve(def, []string{"W1", "W2", "W3", "A1", "A2"})
+	assert.False(t, state.IsWin)
+
+	assert.ElementsMatch(t, []string{"A1"}, FigureWinningTiles(def, []string{"W1", "W2", "W3", "A1"}))
+
+	// Standard suit without kind keeps standard kind
+	def.Suits[0].Kind = ""
+	assert.Equal(t, SuitedTileRule, GetResolverRules(def, TileSuitWan))
+}
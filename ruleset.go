@@ -0,0 +1,149 @@
+package foursquare
+
+// RuleSet gathers rules of a regional variant which decide claims, bonus tiles and payment.
+// Tile set, size of hand, players and scoring are options of game, NewOptionsWithRuleSet fills them for presets.
+type RuleSet struct {
+	Name string `json:"name"`
+
+	// Relative seats of players who are able to chow discarded tile, 1 is the next player (下家).
+	// Nobody is able to chow if it is empty.
+	ChowFrom []int `json:"chow_from"`
+
+	// Tiles of bonus suits are set aside as flowers, then player draws supplement tile
+	BonusSuits []TileSuit `json:"bonus_suits"`
+	BonusTiles []string   `json:"bonus_tiles,omitempty"`
+
+	// Self-drawn is paid as if there were such number of players, shares of empty seats are split among payers.
+	// Nothing changes if it is not greater than number of players.
+	PaymentSeats int `json:"payment_seats,omitempty"`
+}
+
+// 台灣十六張麻將
+var TaiwanRuleSet = &RuleSet{
+	Name:       "taiwan",
+	ChowFrom:   []int{1},
+	BonusSuits: []TileSuit{TileSuitFlower, TileSuitSeason},
+}
+
+// 香港十三張麻將
+var HongKongRuleSet = &RuleSet{
+	Name:       "hongkong",
+	ChowFrom:   []int{1},
+	BonusSuits: []TileSuit{TileSuitFlower, TileSuitSeason},
+}
+
+// 台灣三人麻將，沒有吃牌，北風當花
+var ThreePlayerRuleSet = &RuleSet{
+	Name:         "three_player",
+	ChowFrom:     []int{},
+	BonusSuits:   []TileSuit{TileSuitFlower, TileSuitSeason},
+	BonusTiles:   []string{"I4"},
+	PaymentSeats: 4,
+}
+
+// presetOptions sets options which preset rule set is played with, on top of options of Taiwan rule set
+var presetOptions = map[*RuleSet]func(opts *Options){
+	HongKongRuleSet: func(opts *Options) {
+		opts.HandTileCount = 13
+		opts.PointRules = HongKongRules
+		opts.FlowerRules.SeatFlower = true
+		opts.FlowerRules.FlowerKong = true
+	},
+	ThreePlayerRuleSet: func(opts *Options) {
+		opts.TileSetDef = ThreePlayerSetOfTiles
+		opts.PlayerCount = 3
+	},
+}
+
+// 香港麻將番數
+var HongKongRules map[PointType]PointRule = map[PointType]PointRule{
+	MinimalPoints:      {Type: MinimalPoints, Point: 1},                                                                 // 平糊
+	PungHand:           {Type: PungHand, Point: 3},                                                                      // 對對糊
+	HalfFlush:          {Type: HalfFlush, Point: 3},                                                                     // 混一色
+	FullFlush:          {Type: FullFlush, Point: 7, Excludes: []PointType{HalfFlush}},                                   // 清一色
+	AllHonorsHand:      {Type: AllHonorsHand, Point: 10, Implies: []PointType{HalfFlush, PungHand}},                     // 字一色
+	LittleThreeDragons: {Type: LittleThreeDragons, Point: 5, Implies: []PointType{DragonPung}},                          // 小三元
+	BigThreeDragons:    {Type: BigThreeDragons, Point: 8, Implies: []PointType{LittleThreeDragons, DragonPung}},         // 大三元
+	FourConcealedPungs: {Type: FourConcealedPungs, Point: 13, Implies: []PointType{ThreeConcealedPungs, PungHand}},      // 坎坎糊
+	SmallFourWinds:     {Type: SmallFourWinds, Point: 10},                                                               // 小四喜
+	BigFourWinds:       {Type: BigFourWinds, Point: 13, Implies: []PointType{SmallFourWinds, SeatWind, PrevailingWind}}, // 大四喜
+	MeldedHand:         {Type: MeldedHand, Point: 1, Excludes: []PointType{SelfDrawn}},                                  // 全求人
+	HeavenlyHand:       {Type: HeavenlyHand, Point: 13, Implies: []PointType{SelfDrawn, ConcealedHand}},                 // 天糊
+	EarthlyHand:        {Type: EarthlyHand, Point: 13, Implies: []PointType{SelfDrawn, ConcealedHand}},                  // 地糊
+	AfterAKong:         {Type: AfterAKong, Point: 1},                                                                    // 槓上開花
+	LastTileDraw:       {Type: LastTileDraw, Point: 1},                                                                  // 海底撈月
+	RobbingTheKong:     {Type: RobbingTheKong, Point: 1},                                                                // 搶槓
+	SelfDrawn:          {Type: SelfDrawn, Point: 1},                                                                     // 自摸
+	ConcealedHand:      {Type: ConcealedHand, Point: 1},                                                                 // 門前清
+	SeatWind:           {Type: SeatWind, Point: 1},                                                                      // 門風
+	PrevailingWind:     {Type: PrevailingWind, Point: 1},                                                                // 圈風
+	DragonPung:         {Type: DragonPung, Point: 1},                                                                    // 三元牌
+	SeatFlower:         {Type: SeatFlower, Point: 1},                                                                    // 正花
+	FlowerKong:         {Type: FlowerKong, Point: 2},                                                                    // 一台花
+	EightImmortals:     {Type: EightImmortals, Point: 8, Excludes: []PointType{SevenRobOne}},                            // 大花糊
+	SevenRobOne:        {Type: SevenRobOne, Point: 3},                                                                   // 七搶一
+}
+
+// FindRuleSet returns preset rule set by name
+func FindRuleSet(name string) (*RuleSet, bool) {
+
+	for _, rs := range []*RuleSet{TaiwanRuleSet, HongKongRuleSet, ThreePlayerRuleSet} {
+		if rs.Name == name {
+			return rs, true
+		}
+	}
+
+	return nil, false
+}
+
+// CanChow checks if player at relative seat is able to chow discarded tile
+func (rs *RuleSet) CanChow(relativeSeatIdx int) bool {
+
+	for _, seat := range rs.ChowFrom {
+		if seat == relativeSeatIdx {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsBonusTile checks if tile should be set aside as flower, by bonus suits and tiles of rule set and bonus suits
+// of tile set. Wild tiles are kept in hand.
+func (rs *RuleSet) IsBonusTile(tileSetDef *TileSetDef, tile string) bool {
+
+	if len(tile) == 0 || tileSetDef.IsWildTile(tile) {
+		return false
+	}
+
+	suit := TileSuit(tile[0:1])
+
+	if tileSetDef.GetSuit(suit) != nil && tileSetDef.GetSuitKind(suit) == TileKindBonus {
+		return true
+	}
+
+	if rs == nil {
+		return false
+	}
+
+	for _, s := range rs.BonusSuits {
+		if s == suit {
+			return true
+		}
+	}
+
+	return ContainsTile(rs.BonusTiles, tile)
+}
+
+func (g *Game) getRuleSet() *RuleSet {
+
+	if g.gs.Meta.RuleSet == nil {
+		return TaiwanRuleSet
+	}
+
+	return g.gs.Meta.RuleSet
+}
+
+func (g *Game) isBonusTile(tile string) bool {
+	return g.getRuleSet().IsBonusTile(g.gs.Meta.TileSetDef, tile)
+}
@@ -0,0 +1,116 @@
+package foursquare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RuleSet_CanChow(t *testing.T) {
+
+	assert.True(t, TaiwanRuleSet.CanChow(1))
+	assert.False(t, TaiwanRuleSet.CanChow(2))
+	assert.False(t, TaiwanRuleSet.CanChow(3))
+
+	rs := &RuleSet{}
+	assert.False(t, rs.CanChow(1))
+}
+
+func Test_RuleSet_IsBonusTile(t *testing.T) {
+
+	assert.True(t, TaiwanRuleSet.IsBonusTile(StandardSetOfTiles, "F1"))
+	assert.True(t, TaiwanRuleSet.IsBonusTile(StandardSetOfTiles, "S4"))
+	assert.False(t, TaiwanRuleSet.IsBonusTile(StandardSetOfTiles, "W1"))
+	assert.False(t, TaiwanRuleSet.IsBonusTile(StandardSetOfTiles, "I1"))
+
+	// Bonus suits of tile set
+	rs := &RuleSet{}
+	assert.True(t, rs.IsBonusTile(StandardSetOfTiles, "F1"))
+	assert.False(t, rs.IsBonusTile(&TileSetDef{}, "F1"))
+
+	// Wild tiles are kept in hand
+	def := &TileSetDef{
+		Suits: StandardSetOfTiles.Suits,
+		Wild:  &WildDef{Tiles: []string{"F1"}},
+	}
+
+	assert.False(t, TaiwanRuleSet.IsBonusTile(def, "F1"))
+	assert.True(t, TaiwanRuleSet.IsBonusTile(def, "F2"))
+}
+
+func Test_RuleSet_HongKong(t *testing.T) {
+
+	opts := NewOptionsWithRuleSet(HongKongRuleSet)
+	opts.Dices = RollDices()
+	opts.Tiles = ShuffleTiles(NewTileSet(opts.TileSetDef))
+
+	assert.Equal(t, 13, opts.HandTileCount)
+	assert.True(t, opts.FlowerRules.SeatFlower)
+
+	g := NewGame(opts)
+	assert.Nil(t, g.StartGame())
+
+	for _, ps := range g.gs.Players {
+		assert.Equal(t, 13, len(ps.Hand.Tiles))
+	}
+}
+
+func Test_RuleSet_FigureReactions_NoChow(t *testing.T) {
+
+	h := NewHand()
+	h.Tiles = []string{"W1", "W2", "T5", "T5", "T8"}
+
+	rs := &RuleSet{
+		ChowFrom: []int{},
+	}
+
+	actions := h.FigureReactions(StandardSetOfTiles, "W3", 1)
+	assert.Equal(t, 1, len(actions))
+	assert.Equal(t, "chow", actions[0].Name)
+
+	actions = h.FigureReactionsWithRuleSet(StandardSetOfTiles, "W3", 1, rs)
+	assert.Equal(t, 0, len(actions))
+
+	actions = h.FigureReactionsWithRuleSet(StandardSetOfTiles, "T5", 2, rs)
+	assert.Equal(t, 1, len(actions))
+	assert.Equal(t, "pung", actions[0].Name)
+}
+
+func Test_RuleSet_ThreePlayer(t *testing.T) {
+
+	assert.True(t, ThreePlayerRuleSet.IsBonusTile(ThreePlayerSetOfTiles, "I4"))
+	assert.False(t, ThreePlayerRuleSet.IsBonusTile(ThreePlayerSetOfTiles, "I1"))
+	assert.False(t, ThreePlayerRuleSet.CanChow(1))
+
+	opts := NewOptionsWithRuleSet(ThreePlayerRuleSet)
+	opts.Dices = RollDices()
+	opts.Tiles = ShuffleTiles(NewTileSet(opts.TileSetDef))
+
+	g := NewGame(opts)
+	assert.Nil(t, g.StartGame())
+	assert.Equal(t, 3, len(g.gs.Players))
+
+	for _, ps := range g.gs.Players {
+		assert.Equal(t, 16, len(ps.Hand.Tiles))
+		assert.False(t, ContainsTile(ps.Hand.Tiles, "I4"))
+		assert.NotEqual(t, "I4", ps.Wind)
+	}
+}
+
+func Test_RuleSet_PresetOptions(t *testing.T) {
+
+	opts := NewOptionsWithRuleSet(HongKongRuleSet)
+	assert.Equal(t, 13, opts.HandTileCount)
+	assert.Equal(t, HongKongRules, opts.PointRules)
+
+	opts = NewOptionsWithRuleSet(ThreePlayerRuleSet)
+	assert.Equal(t, 3, opts.PlayerCount)
+	assert.Equal(t, ThreePlayerSetOfTiles, opts.TileSetDef)
+
+	// Rule set which is not a preset is played with options of Taiwan rule set
+	rs := &RuleSet{Name: "custom"}
+	opts = NewOptionsWithRuleSet(rs)
+	assert.Same(t, rs, opts.RuleSet)
+	assert.Equal(t, 16, opts.HandTileCount)
+	assert.Equal(t, StandardRules, opts.PointRules)
+}
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+const connectionBufferSize = 64
+
+// connection writes messages to WebSocket in its own goroutine
+type connection struct {
+	ws        *websocket.Conn
+	send      chan *Message
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newConnection(ws *websocket.Conn) *connection {
+
+	c := &connection{
+		ws:     ws,
+		send:   make(chan *Message, connectionBufferSize),
+		closed: make(chan struct{}),
+	}
+
+	go c.writeLoop()
+
+	return c
+}
+
+// Send queues message, connection is closed if client is too slow to receive messages
+func (c *connection) Send(msg *Message) {
+
+	select {
+	case <-c.closed:
+	case c.send <- msg:
+	default:
+		c.Close()
+	}
+}
+
+// Close stops connection, messages in queue are still delivered before WebSocket is closed
+func (c *connection) Close() {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+}
+
+func (c *connection) writeLoop() {
+
+	defer c.ws.Close()
+
+	for {
+		select {
+		case <-c.closed:
+			c.flush()
+			return
+		case msg := <-c.send:
+			if err := c.ws.WriteJSON(msg); err != nil {
+				c.Close()
+				return
+			}
+		}
+	}
+}
+
+func (c *connection) flush() {
+
+	for {
+		select {
+		case msg := <-c.send:
+			if err := c.ws.WriteJSON(msg); err != nil {
+				return
+			}
+		default:
+			c.ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+			return
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"github.com/weedbox/foursquare"
+)
+
+const (
+	MessageType_Joined = "joined" // Player took the seat, token is for joining the seat again
+	MessageType_State  = "state"  // State was changed
+	MessageType_Decide = "decide" // Player has to make a decision
+	MessageType_Error  = "error"
+)
+
+const (
+	Decision_Action   = "action"   // After drawing a tile, act with win, kong or discard
+	Decision_Discard  = "discard"  // Discard a tile or declare ready hand
+	Decision_Reaction = "reaction" // React to discarded tile or kong
+)
+
+// Message is sent from server to player
+type Message struct {
+	Type     string                `json:"type"`
+	Seat     int                   `json:"seat"`
+	Token    string                `json:"token,omitempty"` // Token of seat, it is sent to the player who joined only
+	Decision string                `json:"decision,omitempty"`
+	State    *foursquare.GameState `json:"state,omitempty"`
+	Error    string                `json:"error,omitempty"`
+	Code     string                `json:"code,omitempty"` // Code of error which game returned, see foursquare.ErrorCode
+}
+
+// Command is sent from player to server, it maps to DiscardTile, ReadyHand, Act and React of game
+type Command struct {
+	Name   string   `json:"name"`             // discard, readyhand, act or react
+	Action string   `json:"action,omitempty"` // Action for act and react, empty reaction is pass
+	Tile   string   `json:"tile,omitempty"`   // Tile for discard and readyhand
+	Tiles  []string `json:"tiles,omitempty"`  // Selected tiles for chow
+}
+
+// toDecision converts command to decision of agent, it fails if command doesn't answer the decision
+func (c *Command) toDecision(decision string) (*foursquare.Decision, error) {
+
+	switch {
+	case c.Name == "act" && decision == Decision_Action:
+		return &foursquare.Decision{Action: c.Action}, nil
+	case c.Name == "discard" && decision == Decision_Discard:
+		return &foursquare.Decision{Action: "discard", Tile: c.Tile}, nil
+	case c.Name == "readyhand" && decision == Decision_Discard:
+		return &foursquare.Decision{Action: "readyhand", Tile: c.Tile}, nil
+	case c.Name == "react" && decision == Decision_Reaction:
+		return &foursquare.Decision{Action: c.Action, Tiles: c.Tiles}, nil
+	}
+
+	return nil, ErrUnexpectedCommand
+}
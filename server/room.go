@@ -0,0 +1,413 @@
+package server
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/weedbox/foursquare"
+)
+
+var (
+	ErrRoomNotFound      = errors.New("server: room not found")
+	ErrRoomExists        = errors.New("server: room exists")
+	ErrRoomClosed        = errors.New("server: room was closed")
+	ErrInvalidSeat       = errors.New("server: invalid seat")
+	ErrSeatTaken         = errors.New("server: seat was taken")
+	ErrInvalidToken      = errors.New("server: invalid token")
+	ErrUnexpectedCommand = errors.New("server: unexpected command")
+)
+
+type RoomOptions struct {
+	Bots []int `json:"bots"` // Seats for bots
+	Seed int64 `json:"seed"` // Seed of dices and tiles, random if it is zero
+}
+
+type RoomInfo struct {
+	ID        string    `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+	Bots      []int     `json:"bots"`
+	Players   []int     `json:"players"` // Seats of connected players
+	Started   bool      `json:"started"`
+	Event     string    `json:"event"`
+}
+
+// Room runs a game for players who are connected with WebSocket, empty seats are taken by bots
+type Room struct {
+	ID        string
+	CreatedAt time.Time
+
+	opts  *RoomOptions
+	game  *foursquare.Game
+	table *foursquare.Table
+
+	mu        sync.Mutex
+	players   map[int]*remotePlayer
+	started   bool
+	event     string
+	lastSeat  int
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func NewRoom(opts *RoomOptions) *Room {
+
+	seed := opts.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	r := rand.New(rand.NewSource(seed))
+
+	gopts := foursquare.NewOptions()
+	gopts.Dices = foursquare.RollDicesWithRand(r)
+	gopts.Tiles = foursquare.ShuffleTilesWithRand(foursquare.NewTileSet(gopts.TileSetDef), r)
+
+	room := &Room{
+		ID:        uuid.New().String(),
+		CreatedAt: time.Now(),
+		opts:      opts,
+		game:      foursquare.NewGame(gopts),
+		players:   make(map[int]*remotePlayer),
+		closed:    make(chan struct{}),
+	}
+
+	room.table = foursquare.NewTable(room.game)
+
+	for i := 0; i < gopts.PlayerCount; i++ {
+
+		if room.isBotSeat(i) {
+			room.table.Sit(i, foursquare.NewBot())
+			continue
+		}
+
+		p := newRemotePlayer(room, i)
+		room.players[i] = p
+		room.table.Sit(i, p)
+	}
+
+	return room
+}
+
+func (r *Room) isBotSeat(seat int) bool {
+
+	for _, s := range r.opts.Bots {
+		if s == seat {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Room) Info() *RoomInfo {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	info := &RoomInfo{
+		ID:        r.ID,
+		CreatedAt: r.CreatedAt,
+		Bots:      r.opts.Bots,
+		Players:   make([]int, 0),
+		Started:   r.started,
+		Event:     r.event,
+	}
+
+	for seat, p := range r.players {
+		if p.conn != nil {
+			info.Players = append(info.Players, seat)
+		}
+	}
+
+	sort.Ints(info.Players)
+
+	return info
+}
+
+// Join connects player to seat, game starts when all seats were taken.
+// Token of seat is issued when player joins the first time, the same token is required to join the seat again.
+func (r *Room) Join(seat int, token string, conn *connection) (string, error) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	select {
+	case <-r.closed:
+		return "", ErrRoomClosed
+	default:
+	}
+
+	p, ok := r.players[seat]
+	if !ok {
+		return "", ErrInvalidSeat
+	}
+
+	if p.conn != nil {
+		return "", ErrSeatTaken
+	}
+
+	if p.token == "" {
+		p.token = uuid.New().String()
+	} else if token != p.token {
+		return "", ErrInvalidToken
+	}
+
+	p.conn = conn
+
+	conn.Send(&Message{Type: MessageType_Joined, Seat: seat, Token: p.token})
+
+	// Catch up with the game
+	if p.state != nil {
+		conn.Send(p.state)
+	}
+
+	if p.pending != nil {
+		conn.Send(p.pending)
+	}
+
+	r.startIfReady()
+
+	return p.token, nil
+}
+
+// Leave disconnects player from seat, player is able to join again
+func (r *Room) Leave(seat int, conn *connection) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.players[seat]
+	if !ok || p.conn != conn {
+		return
+	}
+
+	p.conn = nil
+}
+
+// Start runs game if nobody has to join, it is for rooms with bots only
+func (r *Room) Start() {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.startIfReady()
+}
+
+func (r *Room) startIfReady() {
+
+	if r.started {
+		return
+	}
+
+	for _, p := range r.players {
+		if p.conn == nil {
+			return
+		}
+	}
+
+	r.started = true
+
+	go r.run()
+}
+
+func (r *Room) Close() {
+	r.closeOnce.Do(func() {
+		close(r.closed)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+
+		for _, p := range r.players {
+			if p.conn != nil {
+				p.conn.Close()
+				p.conn = nil
+			}
+		}
+	})
+}
+
+func (r *Room) Done() <-chan struct{} {
+	return r.closed
+}
+
+func (r *Room) run() {
+
+	err := r.game.StartGame()
+	if err != nil {
+		r.Close()
+		return
+	}
+
+	r.broadcast()
+
+	gs := r.game.GetState()
+	for gs.Status.CurrentEvent != foursquare.GetGameEventSymbols(foursquare.GameEvent_GameClosed) {
+
+		r.setLastSeat(-1)
+
+		err := r.table.Step()
+		if err == ErrRoomClosed {
+			return
+		}
+
+		if err != nil {
+
+			// Player made an invalid decision, ask again
+			if p := r.getLastPlayer(); p != nil {
+				p.sendError(err)
+				continue
+			}
+
+			r.Close()
+			return
+		}
+
+		r.broadcast()
+	}
+}
+
+func (r *Room) setLastSeat(seat int) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastSeat = seat
+}
+
+func (r *Room) getLastPlayer() *remotePlayer {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.players[r.lastSeat]
+}
+
+// broadcast sends state to all players, each player sees what the seat is able to see
+func (r *Room) broadcast() {
+
+	gs := r.game.GetState()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.event = gs.Status.CurrentEvent
+
+	for seat, p := range r.players {
+
+		p.state = &Message{
+			Type:  MessageType_State,
+			Seat:  seat,
+			State: gs.Redact(seat),
+		}
+
+		if p.conn != nil {
+			p.conn.Send(p.state)
+		}
+	}
+}
+
+// HandleCommand passes command to the player who is making a decision, token must be the one of seat
+func (r *Room) HandleCommand(seat int, token string, cmd *Command) error {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p, ok := r.players[seat]
+	if !ok {
+		return ErrInvalidSeat
+	}
+
+	if p.token == "" || token != p.token {
+		return ErrInvalidToken
+	}
+
+	if p.pending == nil {
+		return ErrUnexpectedCommand
+	}
+
+	d, err := cmd.toDecision(p.pending.Decision)
+	if err != nil {
+		return err
+	}
+
+	p.pending = nil
+	p.decisions <- d
+
+	return nil
+}
+
+// remotePlayer is agent of a seat, decisions come from WebSocket connection
+type remotePlayer struct {
+	room      *Room
+	seat      int
+	token     string
+	conn      *connection
+	state     *Message
+	pending   *Message
+	decisions chan *foursquare.Decision
+}
+
+func newRemotePlayer(room *Room, seat int) *remotePlayer {
+	return &remotePlayer{
+		room:      room,
+		seat:      seat,
+		decisions: make(chan *foursquare.Decision, 1),
+	}
+}
+
+func (p *remotePlayer) sendError(err error) {
+
+	p.room.mu.Lock()
+	defer p.room.mu.Unlock()
+
+	if p.conn != nil {
+		p.conn.Send(&Message{
+			Type:  MessageType_Error,
+			Seat:  p.seat,
+			Error: err.Error(),
+			Code:  string(foursquare.GetErrorCode(err)),
+		})
+	}
+}
+
+func (p *remotePlayer) decide(decision string, gs *foursquare.GameState) (*foursquare.Decision, error) {
+
+	p.room.mu.Lock()
+
+	p.room.lastSeat = p.seat
+	p.pending = &Message{
+		Type:     MessageType_Decide,
+		Seat:     p.seat,
+		Decision: decision,
+		State:    gs.Redact(p.seat),
+	}
+
+	if p.conn != nil {
+		p.conn.Send(p.pending)
+	}
+
+	p.room.mu.Unlock()
+
+	select {
+	case d := <-p.decisions:
+		return d, nil
+	case <-p.room.closed:
+		return nil, ErrRoomClosed
+	}
+}
+
+func (p *remotePlayer) DecideAction(gs *foursquare.GameState, ps *foursquare.PlayerState) (*foursquare.Decision, error) {
+	return p.decide(Decision_Action, gs)
+}
+
+func (p *remotePlayer) DecideDiscard(gs *foursquare.GameState, ps *foursquare.PlayerState) (*foursquare.Decision, error) {
+	return p.decide(Decision_Discard, gs)
+}
+
+func (p *remotePlayer) DecideReaction(gs *foursquare.GameState, ps *foursquare.PlayerState) (*foursquare.Decision, error) {
+	return p.decide(Decision_Reaction, gs)
+}
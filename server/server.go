@@ -0,0 +1,220 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/websocket"
+)
+
+// Server serves rooms over HTTP, players connect to seats with WebSocket:
+//
+//	POST   /rooms                      create a room
+//	GET    /rooms                      list rooms
+//	GET    /rooms/{id}                 get a room
+//	DELETE /rooms/{id}                 close a room
+//	GET    /rooms/{id}/ws?seat=&token= connect to a seat, token is required to join the seat again
+type Server struct {
+	opts     *ServerOptions
+	store    RoomStore
+	upgrader websocket.Upgrader
+}
+
+type ServerOptions struct {
+	Store          RoomStore
+	AllowedOrigins []string // Origins of WebSocket besides the same origin, "*" allows all
+}
+
+func NewServer(store RoomStore) *Server {
+	return NewServerWithOptions(&ServerOptions{
+		Store: store,
+	})
+}
+
+func NewServerWithOptions(opts *ServerOptions) *Server {
+
+	s := &Server{
+		opts:  opts,
+		store: opts.Store,
+	}
+
+	if s.store == nil {
+		s.store = NewMemoryRoomStore()
+	}
+
+	s.upgrader = websocket.Upgrader{
+		CheckOrigin: s.checkOrigin,
+	}
+
+	return s
+}
+
+// checkOrigin accepts WebSocket from the same origin and allowed origins
+func (s *Server) checkOrigin(req *http.Request) bool {
+
+	origin := req.Header.Get("Origin")
+
+	// Not a browser
+	if origin == "" {
+		return true
+	}
+
+	for _, o := range s.opts.AllowedOrigins {
+		if o == "*" || strings.EqualFold(o, origin) {
+			return true
+		}
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(u.Host, req.Host)
+}
+
+func (s *Server) GetStore() RoomStore {
+	return s.store
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if parts[0] != "rooms" {
+		http.NotFound(w, req)
+		return
+	}
+
+	switch {
+	case len(parts) == 1 && req.Method == http.MethodPost:
+		s.createRoom(w, req)
+	case len(parts) == 1 && req.Method == http.MethodGet:
+		s.listRooms(w, req)
+	case len(parts) == 2 && req.Method == http.MethodGet:
+		s.getRoom(w, req, parts[1])
+	case len(parts) == 2 && req.Method == http.MethodDelete:
+		s.deleteRoom(w, req, parts[1])
+	case len(parts) == 3 && parts[2] == "ws" && req.Method == http.MethodGet:
+		s.connect(w, req, parts[1])
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, map[string]string{
+		"error": err.Error(),
+	})
+}
+
+func (s *Server) createRoom(w http.ResponseWriter, req *http.Request) {
+
+	opts := &RoomOptions{}
+	if req.ContentLength != 0 {
+		if err := json.NewDecoder(req.Body).Decode(opts); err != nil {
+			writeError(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	room := NewRoom(opts)
+	if err := s.store.Add(room); err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	// Nobody has to join a room of bots
+	room.Start()
+
+	writeJSON(w, http.StatusCreated, room.Info())
+}
+
+func (s *Server) listRooms(w http.ResponseWriter, req *http.Request) {
+
+	rooms, err := s.store.List()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	infos := make([]*RoomInfo, 0, len(rooms))
+	for _, r := range rooms {
+		infos = append(infos, r.Info())
+	}
+
+	writeJSON(w, http.StatusOK, infos)
+}
+
+func (s *Server) getRoom(w http.ResponseWriter, req *http.Request, id string) {
+
+	room, err := s.store.Get(id)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, room.Info())
+}
+
+func (s *Server) deleteRoom(w http.ResponseWriter, req *http.Request, id string) {
+
+	if err := s.store.Delete(id); err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) connect(w http.ResponseWriter, req *http.Request, id string) {
+
+	room, err := s.store.Get(id)
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+
+	seat, err := strconv.Atoi(req.URL.Query().Get("seat"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, ErrInvalidSeat)
+		return
+	}
+
+	ws, err := s.upgrader.Upgrade(w, req, nil)
+	if err != nil {
+		return
+	}
+
+	conn := newConnection(ws)
+
+	token, err := room.Join(seat, req.URL.Query().Get("token"), conn)
+	if err != nil {
+		conn.Send(&Message{Type: MessageType_Error, Seat: seat, Error: err.Error()})
+		conn.Close()
+		return
+	}
+
+	defer room.Leave(seat, conn)
+	defer conn.Close()
+
+	// Read commands from player, connection acts for its own seat only
+	for {
+		var cmd Command
+		if err := ws.ReadJSON(&cmd); err != nil {
+			return
+		}
+
+		if err := room.HandleCommand(seat, token, &cmd); err != nil {
+			conn.Send(&Message{Type: MessageType_Error, Seat: seat, Error: err.Error()})
+		}
+	}
+}
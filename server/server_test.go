@@ -0,0 +1,268 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/weedbox/foursquare"
+)
+
+func createRoom(t *testing.T, ts *httptest.Server, opts *RoomOptions) *RoomInfo {
+
+	body, _ := json.Marshal(opts)
+
+	resp, err := http.Post(ts.URL+"/rooms", "application/json", bytes.NewReader(body))
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	var info RoomInfo
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(&info))
+
+	return &info
+}
+
+func dial(ts *httptest.Server, roomID string, query string, header http.Header) (*websocket.Conn, *http.Response, error) {
+	url := "ws" + strings.TrimPrefix(ts.URL, "http") + "/rooms/" + roomID + "/ws?" + query
+	return websocket.DefaultDialer.Dial(url, header)
+}
+
+func connect(t *testing.T, ts *httptest.Server, roomID string, query string) *websocket.Conn {
+
+	ws, _, err := dial(ts, roomID, query, nil)
+	assert.Nil(t, err)
+
+	ws.SetReadDeadline(time.Now().Add(10 * time.Second))
+
+	return ws
+}
+
+func getMe(msg *Message) *foursquare.PlayerState {
+	return &msg.State.Players[msg.Seat]
+}
+
+func Test_Server_BotsOnly(t *testing.T) {
+
+	s := NewServer(nil)
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+
+	info := createRoom(t, ts, &RoomOptions{Bots: []int{0, 1, 2, 3}, Seed: 1})
+	assert.True(t, info.Started)
+
+	room, err := s.GetStore().Get(info.ID)
+	assert.Nil(t, err)
+
+	deadline := time.Now().Add(10 * time.Second)
+	for room.Info().Event != foursquare.GetGameEventSymbols(foursquare.GameEvent_GameClosed) {
+		assert.True(t, time.Now().Before(deadline))
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// List and delete
+	resp, err := http.Get(ts.URL + "/rooms")
+	assert.Nil(t, err)
+
+	var infos []*RoomInfo
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(&infos))
+	resp.Body.Close()
+	assert.Equal(t, 1, len(infos))
+
+	req, _ := http.NewRequest(http.MethodDelete, ts.URL+"/rooms/"+info.ID, nil)
+	resp, err = http.DefaultClient.Do(req)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, err = http.Get(ts.URL + "/rooms/" + info.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func Test_Server_PlayWithBots(t *testing.T) {
+
+	s := NewServer(nil)
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+
+	info := createRoom(t, ts, &RoomOptions{Bots: []int{1, 2, 3}, Seed: 2})
+	assert.False(t, info.Started)
+
+	// Seat of bot
+	ws := connect(t, ts, info.ID, "seat=1")
+	var msg Message
+	assert.Nil(t, ws.ReadJSON(&msg))
+	assert.Equal(t, MessageType_Error, msg.Type)
+	assert.Equal(t, ErrInvalidSeat.Error(), msg.Error)
+	ws.Close()
+
+	ws = connect(t, ts, info.ID, "seat=0")
+	defer ws.Close()
+
+	errors := 0
+	codes := make([]string, 0)
+	decisions := 0
+	triedInvalidTile := false
+
+	for {
+		var msg Message
+		assert.Nil(t, ws.ReadJSON(&msg))
+
+		if msg.Type == MessageType_Joined {
+			assert.NotEmpty(t, msg.Token)
+			continue
+		}
+
+		if msg.Type == MessageType_Error {
+			errors++
+			codes = append(codes, msg.Code)
+			continue
+		}
+
+		if msg.Type == MessageType_State {
+
+			// Concealed tiles of others are hidden
+			for _, ps := range msg.State.Players {
+				if ps.Idx != 0 && msg.State.Result == nil && len(ps.Hand.Tiles) > 0 {
+					assert.Equal(t, foursquare.HiddenTile, ps.Hand.Tiles[0])
+				}
+			}
+
+			if msg.State.Status.CurrentEvent == foursquare.GetGameEventSymbols(foursquare.GameEvent_GameClosed) {
+				break
+			}
+
+			continue
+		}
+
+		decisions++
+		me := getMe(&msg)
+
+		switch msg.Decision {
+		case Decision_Action:
+			if me.IsAllowedAction("win") {
+				ws.WriteJSON(&Command{Name: "act", Action: "win"})
+			} else {
+				ws.WriteJSON(&Command{Name: "act", Action: "discard"})
+			}
+
+		case Decision_Discard:
+
+			if !triedInvalidTile {
+				triedInvalidTile = true
+
+				// It doesn't answer the decision
+				ws.WriteJSON(&Command{Name: "react", Action: "pung"})
+
+				// Engine refuses it, then asks again
+				ws.WriteJSON(&Command{Name: "discard", Tile: "X9"})
+				continue
+			}
+
+			tile := me.Hand.Tiles[len(me.Hand.Tiles)-1]
+			if len(me.Hand.Draw) > 0 {
+				tile = me.Hand.Draw[0]
+			}
+
+			ws.WriteJSON(&Command{Name: "discard", Tile: tile})
+
+		case Decision_Reaction:
+			if me.IsAllowedAction("win") {
+				ws.WriteJSON(&Command{Name: "react", Action: "win"})
+			} else {
+				ws.WriteJSON(&Command{Name: "react"})
+			}
+		}
+	}
+
+	assert.Greater(t, decisions, 0)
+	assert.Equal(t, 2, errors)
+
+	// Only errors of game have code
+	assert.Equal(t, []string{"", string(foursquare.ErrorCode_NoSuchTile)}, codes)
+}
+
+func Test_Server_SeatToken(t *testing.T) {
+
+	s := NewServer(nil)
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+
+	info := createRoom(t, ts, &RoomOptions{Bots: []int{2, 3}, Seed: 3})
+
+	room, err := s.GetStore().Get(info.ID)
+	assert.Nil(t, err)
+
+	ws := connect(t, ts, info.ID, "seat=0")
+
+	var joined Message
+	assert.Nil(t, ws.ReadJSON(&joined))
+	assert.Equal(t, MessageType_Joined, joined.Type)
+	assert.Equal(t, 0, joined.Seat)
+	assert.NotEmpty(t, joined.Token)
+
+	// Commands without token of seat are refused, token of seat 0 is not for seat 1
+	assert.Equal(t, ErrInvalidToken, room.HandleCommand(0, "", &Command{Name: "discard"}))
+	assert.Equal(t, ErrInvalidToken, room.HandleCommand(1, joined.Token, &Command{Name: "discard"}))
+
+	ws.Close()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for len(room.Info().Players) > 0 {
+		assert.True(t, time.Now().Before(deadline))
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Nobody else takes the seat
+	ws = connect(t, ts, info.ID, "seat=0")
+	var msg Message
+	assert.Nil(t, ws.ReadJSON(&msg))
+	assert.Equal(t, MessageType_Error, msg.Type)
+	assert.Equal(t, ErrInvalidToken.Error(), msg.Error)
+	ws.Close()
+
+	// Player joins again with token
+	ws = connect(t, ts, info.ID, "seat=0&token="+joined.Token)
+	defer ws.Close()
+
+	assert.Nil(t, ws.ReadJSON(&msg))
+	assert.Equal(t, MessageType_Joined, msg.Type)
+	assert.Equal(t, joined.Token, msg.Token)
+}
+
+func Test_Server_CheckOrigin(t *testing.T) {
+
+	s := NewServer(nil)
+	ts := httptest.NewServer(s)
+	defer ts.Close()
+
+	info := createRoom(t, ts, &RoomOptions{Bots: []int{1, 2, 3}, Seed: 4})
+
+	// Other origins are refused by default
+	_, resp, err := dial(ts, info.ID, "seat=0", http.Header{"Origin": {"http://example.com"}})
+	assert.Equal(t, websocket.ErrBadHandshake, err)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	// Same origin
+	ws, _, err := dial(ts, info.ID, "seat=0", http.Header{"Origin": {ts.URL}})
+	assert.Nil(t, err)
+	ws.Close()
+
+	// Allowed origin
+	s = NewServerWithOptions(&ServerOptions{AllowedOrigins: []string{"http://example.com"}})
+	ts2 := httptest.NewServer(s)
+	defer ts2.Close()
+
+	info = createRoom(t, ts2, &RoomOptions{Bots: []int{1, 2, 3}, Seed: 4})
+
+	ws, _, err = dial(ts2, info.ID, "seat=0", http.Header{"Origin": {"http://example.com"}})
+	assert.Nil(t, err)
+	ws.Close()
+}
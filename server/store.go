@@ -0,0 +1,85 @@
+package server
+
+import (
+	"sort"
+	"sync"
+)
+
+// RoomStore keeps rooms of server
+type RoomStore interface {
+	Add(r *Room) error
+	Get(id string) (*Room, error)
+	List() ([]*Room, error)
+	Delete(id string) error
+}
+
+type MemoryRoomStore struct {
+	mu    sync.RWMutex
+	rooms map[string]*Room
+}
+
+func NewMemoryRoomStore() *MemoryRoomStore {
+	return &MemoryRoomStore{
+		rooms: make(map[string]*Room),
+	}
+}
+
+func (s *MemoryRoomStore) Add(r *Room) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.rooms[r.ID]; ok {
+		return ErrRoomExists
+	}
+
+	s.rooms[r.ID] = r
+
+	return nil
+}
+
+func (s *MemoryRoomStore) Get(id string) (*Room, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	r, ok := s.rooms[id]
+	if !ok {
+		return nil, ErrRoomNotFound
+	}
+
+	return r, nil
+}
+
+func (s *MemoryRoomStore) List() ([]*Room, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	rooms := make([]*Room, 0, len(s.rooms))
+	for _, r := range s.rooms {
+		rooms = append(rooms, r)
+	}
+
+	sort.Slice(rooms, func(i, j int) bool {
+		return rooms[i].CreatedAt.Before(rooms[j].CreatedAt)
+	})
+
+	return rooms, nil
+}
+
+func (s *MemoryRoomStore) Delete(id string) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.rooms[id]
+	if !ok {
+		return ErrRoomNotFound
+	}
+
+	r.Close()
+	delete(s.rooms, id)
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package foursquare
+
+import (
+	"errors"
+)
+
+var (
+	ErrSessionFinished = errors.New("session: session is finished")
+	ErrNoResult        = errors.New("session: no result")
+)
+
+type SessionOptions struct {
+	Rounds      int      `json:"rounds"` // 東南西北，4 rounds for a full match, never more than winds of seats
+	GameOptions *Options `json:"game_options"`
+}
+
+type SessionState struct {
+	Round         int         `json:"round"`          // Index of prevailing wind
+	Banker        int         `json:"banker"`         // Seat of banker
+	BankerChanges int         `json:"banker_changes"` // Number of banker changes in current round
+	WinningStreak int         `json:"winning_streak"`
+	HandCount     int         `json:"hand_count"`
+	Scores        map[int]int `json:"scores"`
+	Results       []*Result   `json:"results"`
+	IsFinished    bool        `json:"is_finished"`
+}
+
+// Session runs a match of multiple hands with rotating banker and prevailing wind
+type Session struct {
+	opts  *SessionOptions
+	state *SessionState
+}
+
+func NewSessionOptions() *SessionOptions {
+	return &SessionOptions{
+		Rounds:      4,
+		GameOptions: NewOptions(),
+	}
+}
+
+func NewSession(opts *SessionOptions) *Session {
+
+	s := &Session{
+		opts: opts,
+		state: &SessionState{
+			Round:         0,
+			Banker:        opts.GameOptions.Banker,
+			BankerChanges: 0,
+			WinningStreak: 0,
+			HandCount:     0,
+			Scores:        make(map[int]int),
+			Results:       make([]*Result, 0),
+			IsFinished:    false,
+		},
+	}
+
+	for i := 0; i < opts.GameOptions.PlayerCount; i++ {
+		s.state.Scores[i] = 0
+	}
+
+	return s
+}
+
+func NewSessionWithState(opts *SessionOptions, state *SessionState) *Session {
+	return &Session{
+		opts:  opts,
+		state: state,
+	}
+}
+
+func (s *Session) GetState() *SessionState {
+	return s.state
+}
+
+// GetPrevailingWind returns wind of current round, winds which no seat has are skipped in games of less players
+func (s *Session) GetPrevailingWind() string {
+
+	return WindTiles[s.state.Round%s.getWindCount()]
+}
+
+// getWindCount returns number of winds which seats have
+func (s *Session) getWindCount() int {
+
+	if n := s.opts.GameOptions.PlayerCount; n > 0 && n < len(WindTiles) {
+		return n
+	}
+
+	return len(WindTiles)
+}
+
+// getRounds returns number of rounds to play, a wind is never prevailing wind twice
+func (s *Session) getRounds() int {
+
+	winds := s.getWindCount()
+	if s.opts.Rounds <= 0 || s.opts.Rounds > winds {
+		return winds
+	}
+
+	return s.opts.Rounds
+}
+
+// NextOptions returns options for the next hand, dices and tiles of game options are never reused
+func (s *Session) NextOptions() (*Options, error) {
+
+	if s.state.IsFinished {
+		return nil, ErrSessionFinished
+	}
+
+	opts := *s.opts.GameOptions
+	opts.Banker = s.state.Banker
+	opts.PrevailingWind = s.GetPrevailingWind()
+	opts.WinningStreak = s.state.WinningStreak
+
+	// New dices and set of tiles for every hand
+	opts.Dices = RollDices()
+	opts.Tiles = ShuffleTiles(NewTileSet(opts.TileSetDef))
+
+	return &opts, nil
+}
+
+// NextGame returns a new game for the next hand
+func (s *Session) NextGame() (*Game, error) {
+
+	opts, err := s.NextOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewGame(opts), nil
+}
+
+// ApplyResult updates scores, banker and prevailing wind with result of a hand
+func (s *Session) ApplyResult(result *Result) error {
+
+	if s.state.IsFinished {
+		return ErrSessionFinished
+	}
+
+	if result == nil {
+		return ErrNoResult
+	}
+
+	s.state.HandCount++
+	s.state.Results = append(s.state.Results, result)
+
+	// Carry scores
+	for idx, delta := range result.Deltas {
+		s.state.Scores[idx] += delta
+	}
+
+	// Banker stays
+	if result.NextWinningStreak > 0 {
+		s.state.WinningStreak = result.NextWinningStreak
+		return nil
+	}
+
+	// Next banker
+	s.state.WinningStreak = 0
+	s.state.Banker = (s.state.Banker + 1) % s.opts.GameOptions.PlayerCount
+	s.state.BankerChanges++
+
+	// Everybody was banker, next round
+	if s.state.BankerChanges >= s.opts.GameOptions.PlayerCount {
+		s.state.BankerChanges = 0
+		s.state.Round++
+	}
+
+	if s.state.Round >= s.getRounds() {
+		s.state.IsFinished = true
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package foursquare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Session_NextGame(t *testing.T) {
+
+	opts := NewSessionOptions()
+	s := NewSession(opts)
+
+	// Second player becomes banker
+	assert.Nil(t, s.ApplyResult(&Result{
+		DiscardingPlayer:  0,
+		Winners:           map[int]WinnerResult{1: {}},
+		Deltas:            map[int]int{0: -100, 1: 100},
+		NextWinningStreak: 0,
+	}))
+
+	g, err := s.NextGame()
+	assert.Nil(t, err)
+	assert.Nil(t, g.StartGame())
+	assert.Nil(t, g.Ready())
+
+	assert.Equal(t, "I1", g.GetState().Meta.PrevailingWind)
+
+	banker := g.GetPlayer(1)
+	assert.True(t, banker.IsBanker)
+	assert.Equal(t, "I1", banker.Wind)
+	assert.Equal(t, "I2", g.GetPlayer(2).Wind)
+	assert.Equal(t, "I3", g.GetPlayer(3).Wind)
+	assert.Equal(t, "I4", g.GetPlayer(0).Wind)
+	assert.False(t, g.GetPlayer(0).IsBanker)
+
+	// Banker starts
+	assert.Equal(t, 1, g.GetCurrentPlayer().Idx)
+	assert.Equal(t, 17, len(banker.Hand.Tiles))
+}
+
+func Test_Session_Rotation(t *testing.T) {
+
+	opts := NewSessionOptions()
+	s := NewSession(opts)
+
+	bankerLost := &Result{
+		Deltas:            map[int]int{0: 10, 1: -10},
+		NextWinningStreak: 0,
+	}
+
+	bankerStays := &Result{
+		IsDrawnGame:       true,
+		NextWinningStreak: 1,
+	}
+
+	// Banker stays
+	assert.Nil(t, s.ApplyResult(bankerStays))
+	assert.Equal(t, 0, s.GetState().Banker)
+	assert.Equal(t, 1, s.GetState().WinningStreak)
+
+	// East round
+	for i := 1; i <= 4; i++ {
+		assert.Equal(t, "I1", s.GetPrevailingWind())
+		assert.Nil(t, s.ApplyResult(bankerLost))
+		assert.Equal(t, i%4, s.GetState().Banker)
+		assert.Equal(t, 0, s.GetState().WinningStreak)
+	}
+
+	// South round
+	assert.Equal(t, "I2", s.GetPrevailingWind())
+
+	// West and north rounds
+	for i := 0; i < 12; i++ {
+		assert.Nil(t, s.ApplyResult(bankerLost))
+	}
+
+	assert.True(t, s.GetState().IsFinished)
+	assert.Equal(t, 17, s.GetState().HandCount)
+	assert.Equal(t, 160, s.GetState().Scores[0])
+	assert.Equal(t, -160, s.GetState().Scores[1])
+
+	_, err := s.NextGame()
+	assert.Equal(t, ErrSessionFinished, err)
+	assert.Equal(t, ErrSessionFinished, s.ApplyResult(bankerLost))
+}
+
+func Test_Session_NextOptions_NewTiles(t *testing.T) {
+
+	opts := NewSessionOptions()
+	opts.GameOptions.Dices = []int{1, 1, 1}
+	opts.GameOptions.Tiles = NewTileSet(opts.GameOptions.TileSetDef)
+
+	s := NewSession(opts)
+
+	first, err := s.NextOptions()
+	assert.Nil(t, err)
+
+	second, err := s.NextOptions()
+	assert.Nil(t, err)
+
+	// Preset tiles are not reused
+	assert.NotEqual(t, opts.GameOptions.Tiles, first.Tiles)
+	assert.NotEqual(t, first.Tiles, second.Tiles)
+	assert.ElementsMatch(t, opts.GameOptions.Tiles, first.Tiles)
+	assert.Len(t, first.Dices, 2)
+}
+
+func Test_Session_ThreePlayers(t *testing.T) {
+
+	opts := NewSessionOptions()
+	opts.GameOptions.PlayerCount = 3
+
+	s := NewSession(opts)
+
+	bankerLost := &Result{
+		NextWinningStreak: 0,
+	}
+
+	winds := make([]string, 0)
+	for !s.GetState().IsFinished {
+
+		if len(winds) == 0 || winds[len(winds)-1] != s.GetPrevailingWind() {
+			winds = append(winds, s.GetPrevailingWind())
+		}
+
+		assert.Nil(t, s.ApplyResult(bankerLost))
+	}
+
+	// North wind is never prevailing wind, and the match ends after round of west
+	assert.Equal(t, []string{"I1", "I2", "I3"}, winds)
+	assert.Equal(t, 9, s.GetState().HandCount)
+}
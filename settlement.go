@@ -0,0 +1,126 @@
+package foursquare
+
+type Payment struct {
+	From   int `json:"from"`
+	To     int `json:"to"`
+	Points int `json:"points"`
+	Amount int `json:"amount"`
+}
+
+func (g *Game) settle() {
+
+	result := g.gs.Result
+	if result == nil {
+		return
+	}
+
+	result.Payments = make([]Payment, 0)
+	result.Deltas = make(map[int]int)
+
+	for _, p := range g.gs.Players {
+		result.Deltas[p.Idx] = 0
+	}
+
+	result.NextWinningStreak = g.figureNextWinningStreak()
+
+	// Nobody pays for drawn game
+	if result.IsDrawnGame {
+		return
+	}
+
+	pc := g.getPointCalculator()
+
+	for _, p := range g.gs.Players {
+
+		winner, ok := result.Winners[p.Idx]
+		if !ok {
+			continue
+		}
+
+		// Figure out players who have to pay
+		var payers []int
+		if result.DiscardingPlayer == p.Idx {
+
+			// Self-drawn, everybody pays
+			for _, payer := range g.gs.Players {
+				if payer.Idx != p.Idx {
+					payers = append(payers, payer.Idx)
+				}
+			}
+		} else {
+			payers = append(payers, result.DiscardingPlayer)
+		}
+
+		isSelfDrawn := result.DiscardingPlayer == p.Idx
+
+		for _, payer := range payers {
+
+			points := winner.Points
+
+			// Banker pays extra points for self-drawn of other players
+			if g.gs.Players[payer].IsBanker && isSelfDrawn {
+				points += pc.BankerPoints(g.gs.Meta.WinningStreak)
+			}
+
+			amount := g.figureAmount(points)
+			if isSelfDrawn {
+				amount = g.figureSelfDrawnShare(amount, len(payers))
+			}
+
+			g.pay(payer, p.Idx, points, amount)
+		}
+	}
+}
+
+func (g *Game) figureAmount(points int) int {
+	return g.gs.Meta.BasePoint + points*g.gs.Meta.PointValue
+}
+
+// figureSelfDrawnShare splits shares of empty seats among payers, for games with less players
+func (g *Game) figureSelfDrawnShare(amount int, payerCount int) int {
+
+	seats := g.getRuleSet().PaymentSeats
+	if payerCount == 0 || seats <= payerCount+1 {
+		return amount
+	}
+
+	return amount * (seats - 1) / payerCount
+}
+
+func (g *Game) pay(from int, to int, points int, amount int) {
+
+	result := g.gs.Result
+
+	payment := Payment{
+		From:   from,
+		To:     to,
+		Points: points,
+		Amount: amount,
+	}
+
+	result.Payments = append(result.Payments, payment)
+	result.Deltas[from] -= payment.Amount
+	result.Deltas[to] += payment.Amount
+}
+
+func (g *Game) figureNextWinningStreak() int {
+
+	result := g.gs.Result
+
+	banker := g.getBanker()
+	if banker == nil {
+		return 0
+	}
+
+	// Banker stays for drawn game
+	if result.IsDrawnGame && g.gs.Meta.BankerStaysOnDraw {
+		return g.gs.Meta.WinningStreak + 1
+	}
+
+	// Banker won
+	if _, ok := result.Winners[banker.Idx]; ok && g.gs.Meta.BankerStaysOnWin {
+		return g.gs.Meta.WinningStreak + 1
+	}
+
+	return 0
+}
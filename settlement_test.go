@@ -0,0 +1,177 @@
+package foursquare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Settlement(t *testing.T) {
+
+	cases := []struct {
+		Deltas map[int]int
+		Result *Result
+	}{
+		{
+			// Discarding player pays
+			map[int]int{0: -160, 1: 160, 2: 0, 3: 0},
+			&Result{
+				DiscardingPlayer: 0,
+				Winners: map[int]WinnerResult{
+					1: {Points: 3},
+				},
+			},
+		},
+		{
+			// Everybody pays for self-drawn, banker pays extra point
+			map[int]int{0: -160, 1: -140, 2: 440, 3: -140},
+			&Result{
+				DiscardingPlayer: 2,
+				Winners: map[int]WinnerResult{
+					2: {Points: 2},
+				},
+			},
+		},
+		{
+			// Multiple winners
+			map[int]int{0: 200, 1: 120, 2: -320, 3: 0},
+			&Result{
+				DiscardingPlayer: 2,
+				Winners: map[int]WinnerResult{
+					0: {Points: 5},
+					1: {Points: 1},
+				},
+			},
+		},
+		{
+			// Drawn game
+			map[int]int{0: 0, 1: 0, 2: 0, 3: 0},
+			&Result{
+				IsDrawnGame: true,
+			},
+		},
+	}
+
+	for i, c := range cases {
+
+		opts := NewOptions()
+		opts.BasePoint = 100
+		opts.PointValue = 20
+		opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+		g := NewGame(opts)
+		g.InitializeGame()
+
+		g.gs.Result = c.Result
+		g.settle()
+
+		assert.Equal(t, c.Deltas, g.gs.Result.Deltas, i)
+	}
+}
+
+func Test_Settlement_WinningStreak(t *testing.T) {
+
+	cases := []struct {
+		WinningStreak     int
+		NextWinningStreak int
+		Deltas            map[int]int
+		Result            *Result
+	}{
+		{
+			// Banker won, 連二拉二
+			2,
+			3,
+			map[int]int{0: 300, 1: -100, 2: -100, 3: -100},
+			&Result{
+				DiscardingPlayer: 0,
+				Winners: map[int]WinnerResult{
+					0: {Points: 0},
+				},
+			},
+		},
+		{
+			// Self-drawn by other player, banker pays 1 + 2*2 points
+			2,
+			0,
+			map[int]int{0: -200, 1: 400, 2: -100, 3: -100},
+			&Result{
+				DiscardingPlayer: 1,
+				Winners: map[int]WinnerResult{
+					1: {Points: 0},
+				},
+			},
+		},
+		{
+			// Drawn game, banker stays
+			1,
+			2,
+			map[int]int{0: 0, 1: 0, 2: 0, 3: 0},
+			&Result{
+				IsDrawnGame: true,
+			},
+		},
+	}
+
+	for i, c := range cases {
+
+		opts := NewOptions()
+		opts.BasePoint = 100
+		opts.PointValue = 20
+		opts.WinningStreak = c.WinningStreak
+		opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+		g := NewGame(opts)
+		g.InitializeGame()
+
+		g.gs.Result = c.Result
+		g.settle()
+
+		assert.Equal(t, c.Deltas, g.gs.Result.Deltas, i)
+		assert.Equal(t, c.NextWinningStreak, g.gs.Result.NextWinningStreak, i)
+	}
+}
+
+func Test_Settlement_ThreePlayer(t *testing.T) {
+
+	cases := []struct {
+		Deltas map[int]int
+		Result *Result
+	}{
+		{
+			// Discarding player pays
+			map[int]int{0: -160, 1: 160, 2: 0},
+			&Result{
+				DiscardingPlayer: 0,
+				Winners: map[int]WinnerResult{
+					1: {Points: 3},
+				},
+			},
+		},
+		{
+			// Share of empty seat is split for self-drawn
+			map[int]int{0: -240, 1: -210, 2: 450},
+			&Result{
+				DiscardingPlayer: 2,
+				Winners: map[int]WinnerResult{
+					2: {Points: 2},
+				},
+			},
+		},
+	}
+
+	for i, c := range cases {
+
+		opts := NewOptionsWithRuleSet(ThreePlayerRuleSet)
+		opts.BasePoint = 100
+		opts.PointValue = 20
+		opts.Tiles = NewTileSet(opts.TileSetDef)
+
+		g := NewGame(opts)
+		g.InitializeGame()
+
+		g.gs.Result = c.Result
+		g.settle()
+
+		assert.Equal(t, c.Deltas, g.gs.Result.Deltas, i)
+	}
+}
@@ -0,0 +1,183 @@
+package foursquare
+
+import (
+	"math/rand"
+)
+
+type SimulatorOptions struct {
+	Games       int                       `json:"games"`
+	Seed        int64                     `json:"seed"`
+	GameOptions *Options                  `json:"game_options"`
+	NewAgent    func(playerIdx int) Agent `json:"-"` // Agents of every game, bots are used if it is nil
+}
+
+// SimulationStats is aggregate statistics of simulated games
+type SimulationStats struct {
+	Games       int               `json:"games"`
+	DrawnGames  int               `json:"drawn_games"`
+	Wins        map[int]int       `json:"wins"`     // Wins of seats
+	DealIns     map[int]int       `json:"deal_ins"` // 放槍，discarded tile that other player won with
+	SelfDrawn   int               `json:"self_drawn"`
+	WinnerCount int               `json:"winner_count"`
+	TotalPoints int               `json:"total_points"`
+	TotalTurns  int               `json:"total_turns"`
+	PointTypes  map[PointType]int `json:"point_types"` // Number of winning hands with point type
+	Scores      map[int]int       `json:"scores"`
+}
+
+// Simulator plays a number of games with agents and collects statistics
+type Simulator struct {
+	opts  *SimulatorOptions
+	stats *SimulationStats
+}
+
+func NewSimulatorOptions() *SimulatorOptions {
+	return &SimulatorOptions{
+		Games:       100,
+		Seed:        1,
+		GameOptions: NewOptions(),
+		NewAgent:    nil,
+	}
+}
+
+func NewSimulator(opts *SimulatorOptions) *Simulator {
+	return &Simulator{
+		opts: opts,
+		stats: &SimulationStats{
+			Wins:       make(map[int]int),
+			DealIns:    make(map[int]int),
+			PointTypes: make(map[PointType]int),
+			Scores:     make(map[int]int),
+		},
+	}
+}
+
+func (s *Simulator) GetStats() *SimulationStats {
+	return s.stats
+}
+
+// Run plays all games, game i is seeded with Seed+i so that every game is reproducible
+func (s *Simulator) Run() (*SimulationStats, error) {
+
+	for i := 0; i < s.opts.Games; i++ {
+
+		result, g, err := s.play(s.opts.Seed + int64(i))
+		if err != nil {
+			return s.stats, err
+		}
+
+		s.collect(g, result)
+	}
+
+	return s.stats, nil
+}
+
+func (s *Simulator) play(seed int64) (*Result, *Game, error) {
+
+	r := rand.New(rand.NewSource(seed))
+
+	opts := *s.opts.GameOptions
+	opts.Dices = RollDicesWithRand(r)
+	opts.Tiles = ShuffleTilesWithRand(NewTileSet(opts.TileSetDef), r)
+
+	g := NewGame(&opts)
+
+	table := NewTable(g)
+	for i := 0; i < opts.PlayerCount; i++ {
+
+		if s.opts.NewAgent == nil {
+			table.Sit(i, NewBot())
+			continue
+		}
+
+		table.Sit(i, s.opts.NewAgent(i))
+	}
+
+	result, err := table.Run()
+	if err != nil {
+		return nil, g, err
+	}
+
+	return result, g, nil
+}
+
+func (s *Simulator) collect(g *Game, result *Result) {
+
+	stats := s.stats
+
+	stats.Games++
+	stats.TotalTurns += g.GetState().Status.Turn
+
+	for idx, delta := range result.Deltas {
+		stats.Scores[idx] += delta
+	}
+
+	if result.IsDrawnGame {
+		stats.DrawnGames++
+		return
+	}
+
+	isSelfDrawn := false
+
+	for idx, winner := range result.Winners {
+
+		stats.Wins[idx]++
+		stats.WinnerCount++
+		stats.TotalPoints += winner.Points
+
+		for pt := range winner.Conditions {
+			stats.PointTypes[pt]++
+		}
+
+		if idx == result.DiscardingPlayer {
+			isSelfDrawn = true
+		}
+	}
+
+	if isSelfDrawn {
+		stats.SelfDrawn++
+		return
+	}
+
+	stats.DealIns[result.DiscardingPlayer]++
+}
+
+func (stats *SimulationStats) WinRate(playerIdx int) float64 {
+	return stats.rate(stats.Wins[playerIdx], stats.Games)
+}
+
+func (stats *SimulationStats) DealInRate(playerIdx int) float64 {
+	return stats.rate(stats.DealIns[playerIdx], stats.Games)
+}
+
+func (stats *SimulationStats) DrawRate() float64 {
+	return stats.rate(stats.DrawnGames, stats.Games)
+}
+
+func (stats *SimulationStats) SelfDrawnRate() float64 {
+	return stats.rate(stats.SelfDrawn, stats.Games-stats.DrawnGames)
+}
+
+// AveragePoints returns average points of winning hands
+func (stats *SimulationStats) AveragePoints() float64 {
+	return stats.rate(stats.TotalPoints, stats.WinnerCount)
+}
+
+// AverageTurns returns average number of draws in a game
+func (stats *SimulationStats) AverageTurns() float64 {
+	return stats.rate(stats.TotalTurns, stats.Games)
+}
+
+// PointTypeFrequency returns how often point type shows up in winning hands
+func (stats *SimulationStats) PointTypeFrequency(pt PointType) float64 {
+	return stats.rate(stats.PointTypes[pt], stats.WinnerCount)
+}
+
+func (stats *SimulationStats) rate(n int, total int) float64 {
+
+	if total == 0 {
+		return 0
+	}
+
+	return float64(n) / float64(total)
+}
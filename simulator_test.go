@@ -0,0 +1,68 @@
+package foursquare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Simulator_Run(t *testing.T) {
+
+	opts := NewSimulatorOptions()
+	opts.Games = 10
+	opts.Seed = 42
+
+	stats, err := NewSimulator(opts).Run()
+	assert.Nil(t, err)
+	assert.Equal(t, 10, stats.Games)
+
+	wins := 0
+	for _, n := range stats.Wins {
+		wins += n
+	}
+
+	assert.Equal(t, stats.WinnerCount, wins)
+	assert.Equal(t, stats.Games-stats.DrawnGames, stats.SelfDrawn+sumOf(stats.DealIns))
+	assert.Greater(t, stats.AverageTurns(), 0.0)
+
+	// Money never comes from nowhere
+	assert.Zero(t, sumOf(stats.Scores))
+}
+
+func Test_Simulator_Reproducible(t *testing.T) {
+
+	opts := NewSimulatorOptions()
+	opts.Games = 5
+	opts.Seed = 7
+
+	s1, err := NewSimulator(opts).Run()
+	assert.Nil(t, err)
+
+	s2, err := NewSimulator(opts).Run()
+	assert.Nil(t, err)
+
+	assert.Equal(t, s1, s2)
+}
+
+func Test_Simulator_Agents(t *testing.T) {
+
+	opts := NewSimulatorOptions()
+	opts.Games = 3
+	opts.NewAgent = func(playerIdx int) Agent {
+		return &drawnTileAgent{}
+	}
+
+	stats, err := NewSimulator(opts).Run()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, stats.Games)
+}
+
+func sumOf(m map[int]int) int {
+
+	sum := 0
+	for _, v := range m {
+		sum += v
+	}
+
+	return sum
+}
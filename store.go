@@ -0,0 +1,301 @@
+package foursquare
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+var (
+	ErrGameNotFound     = errors.New("store: game not found")
+	ErrRevisionConflict = errors.New("store: revision conflict")
+	ErrInvalidGameID    = errors.New("store: invalid game id")
+)
+
+// GameStore keeps game states by GameID.
+//
+// Save refuses state if the stored one is not its previous revision, so a game which was changed
+// by somebody else won't be overwritten with state out of date.
+type GameStore interface {
+	Save(gs *GameState) error
+	Load(gameID string) (*GameState, error)
+	List() ([]string, error)
+	Delete(gameID string) error
+}
+
+// LoadGame restores game from store, game keeps saving to the store
+func LoadGame(store GameStore, gameID string) (*Game, error) {
+
+	gs, err := store.Load(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	g := NewGameWithState(gs)
+	g.SetStore(store)
+
+	return g, nil
+}
+
+func (g *Game) SetStore(store GameStore) {
+
+	g.store = store
+
+	if store != nil {
+		g.saved = g.gs.Clone()
+	}
+}
+
+// save stores state, game goes back to the last saved state if store refuses it.
+// State is restored in place, so anyone who holds state of game sees the restored one.
+func (g *Game) save() error {
+
+	if g.store == nil {
+		return nil
+	}
+
+	err := g.store.Save(g.gs)
+	if err != nil {
+		*g.gs = *g.saved.Clone()
+		return err
+	}
+
+	g.saved = g.gs.Clone()
+
+	return nil
+}
+
+func checkRevision(prev *GameState, gs *GameState) error {
+
+	if gs.GameID == "" {
+		return ErrInvalidGameID
+	}
+
+	if prev != nil && prev.Revision != gs.Revision-1 {
+		return ErrRevisionConflict
+	}
+
+	return nil
+}
+
+// MemoryGameStore keeps copies of states in memory
+type MemoryGameStore struct {
+	mu    sync.RWMutex
+	games map[string]*GameState
+}
+
+func NewMemoryGameStore() *MemoryGameStore {
+	return &MemoryGameStore{
+		games: make(map[string]*GameState),
+	}
+}
+
+func (s *MemoryGameStore) Save(gs *GameState) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err := checkRevision(s.games[gs.GameID], gs)
+	if err != nil {
+		return err
+	}
+
+	s.games[gs.GameID] = gs.Clone()
+
+	return nil
+}
+
+func (s *MemoryGameStore) Load(gameID string) (*GameState, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	gs, ok := s.games[gameID]
+	if !ok {
+		return nil, ErrGameNotFound
+	}
+
+	return gs.Clone(), nil
+}
+
+func (s *MemoryGameStore) List() ([]string, error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ids := make([]string, 0, len(s.games))
+	for id := range s.games {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+func (s *MemoryGameStore) Delete(gameID string) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.games[gameID]; !ok {
+		return ErrGameNotFound
+	}
+
+	delete(s.games, gameID)
+
+	return nil
+}
+
+// FileGameStore keeps each state in a JSON file named by GameID under directory
+type FileGameStore struct {
+	mu  sync.Mutex
+	dir string
+}
+
+func NewFileGameStore(dir string) (*FileGameStore, error) {
+
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileGameStore{
+		dir: dir,
+	}, nil
+}
+
+func (s *FileGameStore) getPath(gameID string) (string, error) {
+
+	// Game ID becomes file name, so it must not point to somewhere else
+	if gameID == "" || strings.ContainsAny(gameID, `/\`) || strings.HasPrefix(gameID, ".") {
+		return "", ErrInvalidGameID
+	}
+
+	return filepath.Join(s.dir, gameID+".json"), nil
+}
+
+func (s *FileGameStore) read(path string) (*GameState, error) {
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, ErrGameNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var gs GameState
+	err = json.Unmarshal(data, &gs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gs, nil
+}
+
+func (s *FileGameStore) Save(gs *GameState) error {
+
+	path, err := s.getPath(gs.GameID)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(gs)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prev, err := s.read(path)
+	if err != nil && err != ErrGameNotFound {
+		return err
+	}
+
+	err = checkRevision(prev, gs)
+	if err != nil {
+		return err
+	}
+
+	// Write to temporary file first, so a crash never leaves a broken state behind
+	f, err := os.CreateTemp(s.dir, gs.GameID+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+
+	if err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+func (s *FileGameStore) Load(gameID string) (*GameState, error) {
+
+	path, err := s.getPath(gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.read(path)
+}
+
+func (s *FileGameStore) List() ([]string, error) {
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0)
+	for _, e := range entries {
+
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
+			continue
+		}
+
+		ids = append(ids, strings.TrimSuffix(e.Name(), ".json"))
+	}
+
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+func (s *FileGameStore) Delete(gameID string) error {
+
+	path, err := s.getPath(gameID)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	err = os.Remove(path)
+	if os.IsNotExist(err) {
+		return ErrGameNotFound
+	}
+
+	return err
+}
@@ -0,0 +1,182 @@
+package foursquare
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestGameStores(t *testing.T) map[string]GameStore {
+
+	fs, err := NewFileGameStore(t.TempDir())
+	assert.Nil(t, err)
+
+	return map[string]GameStore{
+		"memory": NewMemoryGameStore(),
+		"file":   fs,
+	}
+}
+
+func Test_GameStore_SaveAfterCommand(t *testing.T) {
+
+	for name, store := range newTestGameStores(t) {
+
+		opts := NewOptions()
+		opts.Dices = RollDices()
+		opts.Tiles = ShuffleTiles(NewTileSet(opts.TileSetDef))
+		opts.Store = store
+
+		g := NewGame(opts)
+		assert.Nil(t, g.StartGame(), name)
+
+		gs := g.GetState()
+
+		saved, err := store.Load(gs.GameID)
+		assert.Nil(t, err, name)
+		assert.Equal(t, int64(1), saved.Revision, name)
+		assert.Equal(t, GetGameEventSymbols(GameEvent_WaitForReady), saved.Status.CurrentEvent, name)
+
+		assert.Nil(t, g.Ready(), name)
+
+		saved, err = store.Load(gs.GameID)
+		assert.Nil(t, err, name)
+		assert.Equal(t, int64(2), saved.Revision, name)
+		assert.Equal(t, gs.Status.CurrentEvent, saved.Status.CurrentEvent, name)
+
+		ids, err := store.List()
+		assert.Nil(t, err, name)
+		assert.Equal(t, []string{gs.GameID}, ids, name)
+
+		assert.Nil(t, store.Delete(gs.GameID), name)
+		assert.Equal(t, ErrGameNotFound, store.Delete(gs.GameID), name)
+
+		_, err = store.Load(gs.GameID)
+		assert.Equal(t, ErrGameNotFound, err, name)
+	}
+}
+
+func Test_GameStore_Recovery(t *testing.T) {
+
+	for name, store := range newTestGameStores(t) {
+
+		opts := NewOptions()
+		opts.Dices = RollDices()
+		opts.Tiles = ShuffleTiles(NewTileSet(opts.TileSetDef))
+		opts.Store = store
+
+		table := NewTable(NewGame(opts))
+		for i := 0; i < opts.PlayerCount; i++ {
+			table.Sit(i, NewBot())
+		}
+
+		// Play for a while, then pretend that process was gone
+		assert.Nil(t, table.GetGame().StartGame(), name)
+		for i := 0; i < 10; i++ {
+			assert.Nil(t, table.Step(), name)
+		}
+
+		gameID := table.GetGame().GetState().GameID
+
+		g, err := LoadGame(store, gameID)
+		assert.Nil(t, err, name)
+		assert.Equal(t, table.GetGame().GetState(), g.GetState(), name)
+
+		table = NewTable(g)
+		for i := 0; i < opts.PlayerCount; i++ {
+			table.Sit(i, NewBot())
+		}
+
+		result, err := table.Run()
+		assert.Nil(t, err, name)
+		assert.NotNil(t, result, name)
+
+		saved, err := store.Load(gameID)
+		assert.Nil(t, err, name)
+		assert.Equal(t, GetGameEventSymbols(GameEvent_GameClosed), saved.Status.CurrentEvent, name)
+	}
+}
+
+func Test_GameStore_RevisionConflict(t *testing.T) {
+
+	for name, store := range newTestGameStores(t) {
+
+		gs := &GameState{GameID: "game", Revision: 1}
+		assert.Nil(t, store.Save(gs), name)
+
+		gs.Revision = 2
+		assert.Nil(t, store.Save(gs), name)
+
+		// Somebody else has saved revision 2 already
+		stale := &GameState{GameID: "game", Revision: 2}
+		assert.Equal(t, ErrRevisionConflict, store.Save(stale), name)
+
+		// Two copies of the game
+		a, _ := LoadGame(store, "game")
+		b, _ := LoadGame(store, "game")
+		a.GetState().Revision++
+		assert.Nil(t, a.save(), name)
+		b.GetState().Revision++
+		assert.Equal(t, ErrRevisionConflict, b.save(), name)
+
+		assert.Equal(t, ErrInvalidGameID, store.Save(&GameState{}), name)
+	}
+}
+
+func Test_FileGameStore_InvalidGameID(t *testing.T) {
+
+	store, err := NewFileGameStore(t.TempDir())
+	assert.Nil(t, err)
+
+	_, err = store.Load("../game")
+	assert.Equal(t, ErrInvalidGameID, err)
+
+	assert.Equal(t, ErrInvalidGameID, store.Save(&GameState{GameID: "a/b", Revision: 1}))
+}
+
+// failingGameStore refuses to save once it is broken
+type failingGameStore struct {
+	GameStore
+	broken bool
+}
+
+func (s *failingGameStore) Save(gs *GameState) error {
+
+	if s.broken {
+		return errors.New("store: broken")
+	}
+
+	return s.GameStore.Save(gs)
+}
+
+func Test_GameStore_SaveFailure(t *testing.T) {
+
+	store := &failingGameStore{GameStore: NewMemoryGameStore()}
+
+	opts := NewOptions()
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+	opts.Store = store
+
+	g := NewGame(opts)
+	assert.Nil(t, g.StartGame())
+	assert.Nil(t, g.Ready())
+
+	gs := g.GetState()
+	before := gs.Clone()
+
+	// Game stays at the last saved state, state which was taken before shows it as well
+	store.broken = true
+	assert.NotNil(t, g.DiscardTile("W4"))
+	assert.Same(t, gs, g.GetState())
+	assert.Equal(t, before, gs)
+
+	// Game goes on once store works again
+	store.broken = false
+	assert.Nil(t, g.DiscardTile("W4"))
+	assert.Equal(t, before.Revision+1, g.GetState().Revision)
+
+	saved, err := store.Load(before.GameID)
+	assert.Nil(t, err)
+	assert.Equal(t, g.GetState(), saved)
+}
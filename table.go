@@ -0,0 +1,216 @@
+package foursquare
+
+import (
+	"errors"
+)
+
+var (
+	ErrNoAgent          = errors.New("table: no agent for player")
+	ErrInvalidDecision  = errors.New("table: invalid decision")
+	ErrUnexpectedStatus = errors.New("table: unexpected game status")
+)
+
+// Decision is what agent decided to do at a decision point
+type Decision struct {
+	Action string   `json:"action"`          // win, kong, pung, chow, discard, readyhand, or empty to pass
+	Tile   string   `json:"tile,omitempty"`  // Tile to discard
+	Tiles  []string `json:"tiles,omitempty"` // Selected tiles for chow
+}
+
+// Agent makes decisions for a seat, it can be a human, a bot or a replay
+type Agent interface {
+
+	// DecideAction is called after player drew a tile, allowed actions are in ps.AllowedActions
+	DecideAction(gs *GameState, ps *PlayerState) (*Decision, error)
+
+	// DecideDiscard is called when player has to discard a tile
+	DecideDiscard(gs *GameState, ps *PlayerState) (*Decision, error)
+
+	// DecideReaction is called when player is able to react to discarded tile or kong
+	DecideReaction(gs *GameState, ps *PlayerState) (*Decision, error)
+}
+
+// Table drives a game to completion by asking agents whenever game is waiting for input
+type Table struct {
+	game   *Game
+	agents map[int]Agent
+}
+
+var reactionPriority = map[string]int{
+	"win":  3,
+	"kong": 2,
+	"pung": 2,
+	"chow": 1,
+}
+
+func NewTable(g *Game) *Table {
+	return &Table{
+		game:   g,
+		agents: make(map[int]Agent),
+	}
+}
+
+func (t *Table) GetGame() *Game {
+	return t.game
+}
+
+// Sit assigns agent to seat
+func (t *Table) Sit(playerIdx int, agent Agent) {
+	t.agents[playerIdx] = agent
+}
+
+// viewOf returns game state which player is able to see, tiles of others are hidden
+func (t *Table) viewOf(playerIdx int) (*GameState, *PlayerState) {
+
+	gs := t.game.GetState().Redact(playerIdx)
+
+	for i := range gs.Players {
+		if gs.Players[i].Idx == playerIdx {
+			return gs, &gs.Players[i]
+		}
+	}
+
+	return gs, nil
+}
+
+func (t *Table) getAgent(playerIdx int) (Agent, error) {
+
+	agent, ok := t.agents[playerIdx]
+	if !ok {
+		return nil, ErrNoAgent
+	}
+
+	return agent, nil
+}
+
+// Run plays game until it is closed
+func (t *Table) Run() (*Result, error) {
+
+	gs := t.game.GetState()
+
+	if gs.Status.CurrentEvent == "" {
+		err := t.game.StartGame()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for gs.Status.CurrentEvent != GetGameEventSymbols(GameEvent_GameClosed) {
+		err := t.Step()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return gs.Result, nil
+}
+
+// Step asks agents for a decision at current waiting event
+func (t *Table) Step() error {
+
+	gs := t.game.GetState()
+
+	switch GameEventBySymbol[gs.Status.CurrentEvent] {
+	case GameEvent_WaitForReady:
+		return t.game.Ready()
+	case GameEvent_WaitForPlayerAction:
+		return t.askForAction()
+	case GameEvent_WaitForPlayerToDiscardTile:
+		return t.askForDiscard()
+	case GameEvent_WaitForReaction:
+		return t.askForReaction()
+	}
+
+	return ErrUnexpectedStatus
+}
+
+func (t *Table) askForAction() error {
+
+	ps := t.game.GetCurrentPlayer()
+
+	agent, err := t.getAgent(ps.Idx)
+	if err != nil {
+		return err
+	}
+
+	d, err := agent.DecideAction(t.viewOf(ps.Idx))
+	if err != nil {
+		return err
+	}
+
+	if d == nil || d.Action == "" {
+		return t.game.Act("discard")
+	}
+
+	return t.game.Act(d.Action)
+}
+
+func (t *Table) askForDiscard() error {
+
+	ps := t.game.GetCurrentPlayer()
+
+	agent, err := t.getAgent(ps.Idx)
+	if err != nil {
+		return err
+	}
+
+	d, err := agent.DecideDiscard(t.viewOf(ps.Idx))
+	if err != nil {
+		return err
+	}
+
+	if d == nil {
+		return ErrInvalidDecision
+	}
+
+	if d.Action == "readyhand" {
+		return t.game.ReadyHand(d.Tile)
+	}
+
+	return t.game.DiscardTile(d.Tile)
+}
+
+func (t *Table) askForReaction() error {
+
+	gs := t.game.GetState()
+
+	playerIdx := -1
+	var decision *Decision
+
+	// Players next to the discarding player come first if they have the same priority
+	for _, ps := range t.game.getPlayersStartingFrom(gs.Status.CurrentPlayer) {
+
+		if len(ps.AllowedActions) == 0 {
+			continue
+		}
+
+		agent, err := t.getAgent(ps.Idx)
+		if err != nil {
+			return err
+		}
+
+		d, err := agent.DecideReaction(t.viewOf(ps.Idx))
+		if err != nil {
+			return err
+		}
+
+		if d == nil || d.Action == "" {
+			continue
+		}
+
+		if !ps.IsAllowedAction(d.Action) {
+			return ErrInvalidDecision
+		}
+
+		if decision == nil || reactionPriority[d.Action] > reactionPriority[decision.Action] {
+			playerIdx = ps.Idx
+			decision = d
+		}
+	}
+
+	if decision == nil {
+		return t.game.React(-1, "", []string{})
+	}
+
+	return t.game.React(playerIdx, decision.Action, decision.Tiles)
+}
@@ -0,0 +1,168 @@
+package foursquare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// drawnTileAgent wins whenever it can, or discards the tile it just drew
+type drawnTileAgent struct {
+	reactions int
+}
+
+func (a *drawnTileAgent) DecideAction(gs *GameState, ps *PlayerState) (*Decision, error) {
+
+	if ps.IsAllowedAction("win") {
+		return &Decision{Action: "win"}, nil
+	}
+
+	return &Decision{Action: "discard"}, nil
+}
+
+func (a *drawnTileAgent) DecideDiscard(gs *GameState, ps *PlayerState) (*Decision, error) {
+
+	if len(ps.Hand.Draw) > 0 {
+		return &Decision{Action: "discard", Tile: ps.Hand.Draw[0]}, nil
+	}
+
+	return &Decision{Action: "discard", Tile: ps.Hand.Tiles[len(ps.Hand.Tiles)-1]}, nil
+}
+
+func (a *drawnTileAgent) DecideReaction(gs *GameState, ps *PlayerState) (*Decision, error) {
+
+	a.reactions++
+
+	if ps.IsAllowedAction("win") {
+		return &Decision{Action: "win"}, nil
+	}
+
+	return &Decision{}, nil
+}
+
+func Test_Table_Run(t *testing.T) {
+
+	for i := 0; i < 5; i++ {
+
+		opts := NewOptions()
+		opts.Dices = RollDices()
+		opts.Tiles = ShuffleTiles(NewTileSet(opts.TileSetDef))
+
+		table := NewTable(NewGame(opts))
+		for j := 0; j < opts.PlayerCount; j++ {
+			table.Sit(j, &drawnTileAgent{})
+		}
+
+		result, err := table.Run()
+		assert.Nil(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, GetGameEventSymbols(GameEvent_GameClosed), table.GetGame().GetState().Status.CurrentEvent)
+	}
+}
+
+func Test_Table_Run_NoAgent(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+	table := NewTable(NewGame(opts))
+	table.Sit(0, &drawnTileAgent{})
+
+	_, err := table.Run()
+	assert.Equal(t, ErrNoAgent, err)
+}
+
+func Test_Table_Reaction(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(StandardSetOfTiles)
+
+	g := NewGame(opts)
+	assert.Nil(t, g.StartGame())
+	assert.Nil(t, g.Ready())
+	assert.Nil(t, g.DiscardTile("W4"))
+
+	// Second player is able to chow, but agent passes
+	agent := &drawnTileAgent{}
+
+	table := NewTable(g)
+	for j := 0; j < opts.PlayerCount; j++ {
+		table.Sit(j, agent)
+	}
+
+	assert.Nil(t, table.Step())
+	assert.Equal(t, 1, agent.reactions)
+	assert.Equal(t, 0, len(g.GetPlayer(1).Hand.Straight))
+	assert.Equal(t, 1, g.GetCurrentPlayer().Idx)
+	assert.Equal(t, GetGameEventSymbols(GameEvent_WaitForPlayerToDiscardTile), g.GetState().Status.CurrentEvent)
+}
+
+// peekingAgent checks that it never sees tiles of others
+type peekingAgent struct {
+	drawnTileAgent
+	t       *testing.T
+	checked int
+}
+
+func (a *peekingAgent) check(gs *GameState, ps *PlayerState) {
+
+	a.checked++
+
+	assert.Same(a.t, ps, &gs.Players[ps.Idx])
+
+	for _, t := range gs.Meta.Tiles {
+		assert.Equal(a.t, HiddenTile, t)
+	}
+
+	for _, p := range gs.Players {
+
+		if p.Idx == ps.Idx {
+			assert.NotContains(a.t, p.Hand.Tiles, HiddenTile)
+			continue
+		}
+
+		for _, t := range p.Hand.Tiles {
+			assert.Equal(a.t, HiddenTile, t)
+		}
+	}
+}
+
+func (a *peekingAgent) DecideAction(gs *GameState, ps *PlayerState) (*Decision, error) {
+	a.check(gs, ps)
+	return a.drawnTileAgent.DecideAction(gs, ps)
+}
+
+func (a *peekingAgent) DecideDiscard(gs *GameState, ps *PlayerState) (*Decision, error) {
+	a.check(gs, ps)
+	return a.drawnTileAgent.DecideDiscard(gs, ps)
+}
+
+func (a *peekingAgent) DecideReaction(gs *GameState, ps *PlayerState) (*Decision, error) {
+	a.check(gs, ps)
+	return a.drawnTileAgent.DecideReaction(gs, ps)
+}
+
+func Test_Table_AgentsSeeRedactedState(t *testing.T) {
+
+	opts := NewOptions()
+	opts.Dices = RollDices()
+	opts.Tiles = ShuffleTiles(NewTileSet(opts.TileSetDef))
+
+	g := NewGame(opts)
+
+	agent := &peekingAgent{t: t}
+
+	table := NewTable(g)
+	for j := 0; j < opts.PlayerCount; j++ {
+		table.Sit(j, agent)
+	}
+
+	_, err := table.Run()
+	assert.Nil(t, err)
+	assert.NotZero(t, agent.checked)
+
+	// Game itself is untouched
+	assert.NotContains(t, g.GetState().Meta.Tiles, HiddenTile)
+}
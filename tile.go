@@ -1,6 +1,7 @@
 package foursquare
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"time"
@@ -18,30 +19,144 @@ const (
 	TileSuitSeason          = "S"
 )
 
+// TileKind tells how tiles of a suit are played
+type TileKind string
+
+const (
+	TileKindNumbered TileKind = "numbered" // 序數牌, makes straights
+	TileKindHonor    TileKind = "honor"    // 字牌, makes only pungs and pairs
+	TileKindBonus    TileKind = "bonus"    // 花牌, set aside as flowers
+)
+
 type TileDef struct {
-	Suit    TileSuit `json:"suit"`
-	Numbers int      `json:"numbers"`
-	Count   int      `json:"count"`
+	Suit     TileSuit `json:"suit"`
+	Kind     TileKind `json:"kind"`
+	Numbers  int      `json:"numbers"`
+	Count    int      `json:"count"`              // Copies of each tile
+	Excludes []int    `json:"excludes,omitempty"` // Numbers which are removed from suit
 }
 
+// TileSetDef lists suits of tile set, suits which aren't listed are not used
 type TileSetDef struct {
-	Wan    TileDef `json:"wan"`    // 萬
-	Tong   TileDef `json:"tong"`   // 筒
-	Bamboo TileDef `json:"bamboo"` // 條
-	Wind   TileDef `json:"wind"`   // 東南西北
-	Dragon TileDef `json:"dragon"` // 中發白
-	Flower TileDef `json:"flower"` // 梅蘭竹菊
-	Season TileDef `json:"season"` // 春夏秋冬
+	Suits []TileDef `json:"suits"`
+	Wild  *WildDef  `json:"wild,omitempty"`
 }
 
 var StandardSetOfTiles = &TileSetDef{
-	Wan:    TileDef{TileSuitWan, 9, 4},
-	Tong:   TileDef{TileSuitTong, 9, 4},
-	Bamboo: TileDef{TileSuitBamboo, 9, 4},
-	Wind:   TileDef{TileSuitWind, 4, 4},
-	Dragon: TileDef{TileSuitDragon, 3, 4},
-	Flower: TileDef{TileSuitFlower, 4, 1},
-	Season: TileDef{TileSuitSeason, 4, 1},
+	Suits: []TileDef{
+		{TileSuitWan, TileKindNumbered, 9, 4, nil},    // 萬
+		{TileSuitTong, TileKindNumbered, 9, 4, nil},   // 筒
+		{TileSuitBamboo, TileKindNumbered, 9, 4, nil}, // 條
+		{TileSuitWind, TileKindHonor, 4, 4, nil},      // 東南西北
+		{TileSuitDragon, TileKindHonor, 3, 4, nil},    // 中發白
+		{TileSuitFlower, TileKindBonus, 4, 1, nil},    // 梅蘭竹菊
+		{TileSuitSeason, TileKindBonus, 4, 1, nil},    // 春夏秋冬
+	},
+}
+
+// 三人麻將，萬子只留一九
+var ThreePlayerSetOfTiles = &TileSetDef{
+	Suits: []TileDef{
+		{TileSuitWan, TileKindNumbered, 9, 4, []int{2, 3, 4, 5, 6, 7, 8}},
+		{TileSuitTong, TileKindNumbered, 9, 4, nil},
+		{TileSuitBamboo, TileKindNumbered, 9, 4, nil},
+		{TileSuitWind, TileKindHonor, 4, 4, nil},
+		{TileSuitDragon, TileKindHonor, 3, 4, nil},
+		{TileSuitFlower, TileKindBonus, 4, 1, nil},
+		{TileSuitSeason, TileKindBonus, 4, 1, nil},
+	},
+}
+
+// Kinds of suits for definitions which don't tell kind
+var defaultTileKinds = map[TileSuit]TileKind{
+	TileSuitWan:    TileKindNumbered,
+	TileSuitTong:   TileKindNumbered,
+	TileSuitBamboo: TileKindNumbered,
+	TileSuitWind:   TileKindHonor,
+	TileSuitDragon: TileKindHonor,
+	TileSuitFlower: TileKindBonus,
+	TileSuitSeason: TileKindBonus,
+}
+
+// GetSuit returns definition of suit, it is nil if tile set has no such suit
+func (def *TileSetDef) GetSuit(suit TileSuit) *TileDef {
+
+	if def == nil {
+		return nil
+	}
+
+	for i, td := range def.Suits {
+		if td.Suit == suit {
+			return &def.Suits[i]
+		}
+	}
+
+	return nil
+}
+
+// GetSuitKind returns kind of suit, standard kind is used if tile set doesn't tell
+func (def *TileSetDef) GetSuitKind(suit TileSuit) TileKind {
+
+	if td := def.GetSuit(suit); td != nil && td.Kind != "" {
+		return td.Kind
+	}
+
+	return defaultTileKinds[suit]
+}
+
+// UnmarshalJSON also reads the old format which has a field for each suit
+func (def *TileSetDef) UnmarshalJSON(data []byte) error {
+
+	type tileSetDef TileSetDef
+
+	var v struct {
+		tileSetDef
+		Wan    *TileDef `json:"wan"`
+		Tong   *TileDef `json:"tong"`
+		Bamboo *TileDef `json:"bamboo"`
+		Wind   *TileDef `json:"wind"`
+		Dragon *TileDef `json:"dragon"`
+		Flower *TileDef `json:"flower"`
+		Season *TileDef `json:"season"`
+	}
+
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+
+	*def = TileSetDef(v.tileSetDef)
+
+	if def.Suits == nil {
+		for _, td := range []*TileDef{v.Wan, v.Tong, v.Bamboo, v.Wind, v.Dragon, v.Flower, v.Season} {
+			if td != nil && td.Suit != "" {
+				def.Suits = append(def.Suits, *td)
+			}
+		}
+	}
+
+	for i, td := range def.Suits {
+		if td.Kind == "" {
+			def.Suits[i].Kind = defaultTileKinds[td.Suit]
+		}
+	}
+
+	return nil
+}
+
+// 東南西北
+var WindTiles = []string{"I1", "I2", "I3", "I4"}
+
+// FigureSeatWind returns wind of seat, banker always sits at east
+func FigureSeatWind(seatIdx int, bankerIdx int, playerCount int) string {
+
+	if playerCount <= 0 {
+		return ""
+	}
+
+	offset := (seatIdx - bankerIdx + playerCount) % playerCount
+
+	return WindTiles[offset%len(WindTiles)]
 }
 
 func GenTiles(suit TileSuit, numbers int, count int) []string {
@@ -60,16 +175,44 @@ func GenTiles(suit TileSuit, numbers int, count int) []string {
 	return tiles
 }
 
+// GenTiles generates tiles of suit without excluded numbers
+func (td TileDef) GenTiles(count int) []string {
+
+	tiles := make([]string, 0, td.Numbers*count)
+
+	for _, t := range GenTiles(td.Suit, td.Numbers, count) {
+
+		num := 0
+		fmt.Sscanf(t[1:], "%d", &num)
+
+		if td.IsExcluded(num) {
+			continue
+		}
+
+		tiles = append(tiles, t)
+	}
+
+	return tiles
+}
+
+// IsExcluded checks if number was removed from suit
+func (td TileDef) IsExcluded(num int) bool {
+
+	for _, n := range td.Excludes {
+		if n == num {
+			return true
+		}
+	}
+
+	return false
+}
+
 func NewTileSet(opt *TileSetDef) []string {
 
 	tiles := make([]string, 0)
-	tiles = append(tiles, GenTiles(opt.Wan.Suit, opt.Wan.Numbers, opt.Wan.Count)...)
-	tiles = append(tiles, GenTiles(opt.Tong.Suit, opt.Tong.Numbers, opt.Tong.Count)...)
-	tiles = append(tiles, GenTiles(opt.Bamboo.Suit, opt.Bamboo.Numbers, opt.Bamboo.Count)...)
-	tiles = append(tiles, GenTiles(opt.Wind.Suit, opt.Wind.Numbers, opt.Wind.Count)...)
-	tiles = append(tiles, GenTiles(opt.Dragon.Suit, opt.Dragon.Numbers, opt.Dragon.Count)...)
-	tiles = append(tiles, GenTiles(opt.Flower.Suit, opt.Flower.Numbers, opt.Flower.Count)...)
-	tiles = append(tiles, GenTiles(opt.Season.Suit, opt.Season.Numbers, opt.Season.Count)...)
+	for _, td := range opt.Suits {
+		tiles = append(tiles, td.GenTiles(td.Count)...)
+	}
 
 	return tiles
 }
@@ -84,3 +227,13 @@ func ShuffleTiles(tiles []string) []string {
 
 	return tiles
 }
+
+// ShuffleTilesWithRand shuffles tiles with given source, it makes games reproducible
+func ShuffleTilesWithRand(tiles []string, r *rand.Rand) []string {
+
+	r.Shuffle(len(tiles), func(i, j int) {
+		tiles[i], tiles[j] = tiles[j], tiles[i]
+	})
+
+	return tiles
+}
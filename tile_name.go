@@ -0,0 +1,131 @@
+package foursquare
+
+import (
+	"strconv"
+)
+
+var (
+	chineseNumbers = []string{"一", "二", "三", "四", "五", "六", "七", "八", "九"}
+	englishNumbers = []string{"One", "Two", "Three", "Four", "Five", "Six", "Seven", "Eight", "Nine"}
+
+	chineseSuitNames = map[TileSuit]string{
+		TileSuitWan:    "萬",
+		TileSuitTong:   "筒",
+		TileSuitBamboo: "條",
+	}
+
+	englishSuitNames = map[TileSuit]string{
+		TileSuitWan:    "Characters",
+		TileSuitTong:   "Circles",
+		TileSuitBamboo: "Bamboos",
+	}
+
+	chineseHonorNames = map[TileSuit][]string{
+		TileSuitWind:   {"東", "南", "西", "北"},
+		TileSuitDragon: {"中", "發", "白"},
+		TileSuitFlower: {"梅", "蘭", "竹", "菊"},
+		TileSuitSeason: {"春", "夏", "秋", "冬"},
+	}
+
+	englishHonorNames = map[TileSuit][]string{
+		TileSuitWind:   {"East Wind", "South Wind", "West Wind", "North Wind"},
+		TileSuitDragon: {"Red Dragon", "Green Dragon", "White Dragon"},
+		TileSuitFlower: {"Plum", "Orchid", "Bamboo", "Chrysanthemum"},
+		TileSuitSeason: {"Spring", "Summer", "Autumn", "Winter"},
+	}
+
+	// First code point of suit in Unicode Mahjong Tiles block
+	tileGlyphBases = map[TileSuit]rune{
+		TileSuitWind:   0x1F000, // 🀀
+		TileSuitDragon: 0x1F004, // 🀄
+		TileSuitWan:    0x1F007, // 🀇
+		TileSuitBamboo: 0x1F010, // 🀐
+		TileSuitTong:   0x1F019, // 🀙
+		TileSuitFlower: 0x1F022, // 🀢
+		TileSuitSeason: 0x1F026, // 🀦
+	}
+)
+
+func parseTileCode(tile string) (TileSuit, int, bool) {
+
+	if len(tile) < 2 {
+		return "", 0, false
+	}
+
+	num, err := strconv.Atoi(tile[1:])
+	if err != nil || num < 1 {
+		return "", 0, false
+	}
+
+	suit := TileSuit(tile[0:1])
+	if num > getNotationSuitNumbers(suit) {
+		return "", 0, false
+	}
+
+	return suit, num, true
+}
+
+// TileGlyph returns Unicode glyph of tile, for instance 🀇 for W1, it returns code itself for unknown tile
+func TileGlyph(tile string) string {
+
+	suit, num, ok := parseTileCode(tile)
+	if !ok {
+		return tile
+	}
+
+	base, ok := tileGlyphBases[suit]
+	if !ok {
+		return tile
+	}
+
+	return string(base + rune(num-1))
+}
+
+// TileChineseName returns traditional Chinese name of tile, for instance 一萬 for W1
+func TileChineseName(tile string) string {
+
+	suit, num, ok := parseTileCode(tile)
+	if !ok {
+		return tile
+	}
+
+	if name, ok := chineseSuitNames[suit]; ok {
+		return chineseNumbers[num-1] + name
+	}
+
+	if names, ok := chineseHonorNames[suit]; ok {
+		return names[num-1]
+	}
+
+	return tile
+}
+
+// TileEnglishName returns English name of tile, for instance One of Characters for W1
+func TileEnglishName(tile string) string {
+
+	suit, num, ok := parseTileCode(tile)
+	if !ok {
+		return tile
+	}
+
+	if name, ok := englishSuitNames[suit]; ok {
+		return englishNumbers[num-1] + " of " + name
+	}
+
+	if names, ok := englishHonorNames[suit]; ok {
+		return names[num-1]
+	}
+
+	return tile
+}
+
+// TileNames converts tiles with naming function such as TileGlyph or TileChineseName
+func TileNames(tiles []string, fn func(string) string) []string {
+
+	names := make([]string, len(tiles))
+	for i, t := range tiles {
+		names[i] = fn(t)
+	}
+
+	return names
+}
@@ -0,0 +1,43 @@
+package foursquare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TileName(t *testing.T) {
+
+	cases := []struct {
+		Tile    string
+		Glyph   string
+		Chinese string
+		English string
+	}{
+		{"W1", "🀇", "一萬", "One of Characters"},
+		{"W9", "🀏", "九萬", "Nine of Characters"},
+		{"T5", "🀝", "五筒", "Five of Circles"},
+		{"B7", "🀖", "七條", "Seven of Bamboos"},
+		{"I1", "🀀", "東", "East Wind"},
+		{"I3", "🀂", "西", "West Wind"},
+		{"D1", "🀄", "中", "Red Dragon"},
+		{"D2", "🀅", "發", "Green Dragon"},
+		{"D3", "🀆", "白", "White Dragon"},
+		{"F1", "🀢", "梅", "Plum"},
+		{"F4", "🀥", "菊", "Chrysanthemum"},
+		{"S1", "🀦", "春", "Spring"},
+		{"S4", "🀩", "冬", "Winter"},
+		{"X1", "X1", "X1", "X1"},
+		{"D4", "D4", "D4", "D4"},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.Glyph, TileGlyph(c.Tile), c.Tile)
+		assert.Equal(t, c.Chinese, TileChineseName(c.Tile), c.Tile)
+		assert.Equal(t, c.English, TileEnglishName(c.Tile), c.Tile)
+	}
+}
+
+func Test_TileNames(t *testing.T) {
+	assert.Equal(t, []string{"一萬", "發"}, TileNames([]string{"W1", "D2"}, TileChineseName))
+}
@@ -179,8 +179,6 @@ func FigureEyesCandidates(tiles []string, rules *ResolverRules) []string {
 		MakeTiles(suit, []int{3, 6, 9}),
 	}
 
-	parts = append(parts)
-
 	countByPart := []int{
 		CountTargetTiles(tiles, parts[0]) % 3,
 		CountTargetTiles(tiles, parts[1]) % 3,
@@ -411,18 +409,8 @@ func FigureReadyHandConditions(tileSetDef *TileSetDef, suit TileSuit, tiles []st
 	}
 
 	var num int
-
-	switch suit {
-	case TileSuitWan:
-		num = tileSetDef.Wan.Numbers
-	case TileSuitTong:
-		num = tileSetDef.Tong.Numbers
-	case TileSuitBamboo:
-		num = tileSetDef.Bamboo.Numbers
-	case TileSuitWind:
-		num = tileSetDef.Wind.Numbers
-	case TileSuitDragon:
-		num = tileSetDef.Dragon.Numbers
+	if td := tileSetDef.GetSuit(suit); td != nil {
+		num = td.Numbers
 	}
 
 	tries := GenTiles(suit, num, 1)
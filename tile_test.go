@@ -1,6 +1,7 @@
 package foursquare
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -21,3 +22,61 @@ func Test_ShuffleTiles(t *testing.T) {
 
 	assert.Equal(t, 144, len(tiles))
 }
+
+func Test_NewTileSet_ThreePlayer(t *testing.T) {
+
+	tiles := NewTileSet(ThreePlayerSetOfTiles)
+
+	assert.Equal(t, 116, len(tiles))
+	assert.Equal(t, 4, CountSpecificTile(tiles, "W1"))
+	assert.Equal(t, 4, CountSpecificTile(tiles, "W9"))
+	assert.False(t, ContainsTile(tiles, "W5"))
+}
+
+func Test_NewTileSet_CustomSuits(t *testing.T) {
+
+	// Short deck without characters, plus a suit of animal tiles
+	def := &TileSetDef{
+		Suits: []TileDef{
+			{TileSuitTong, TileKindNumbered, 9, 4, nil},
+			{TileSuitBamboo, TileKindNumbered, 9, 4, nil},
+			{TileSuitDragon, TileKindHonor, 3, 4, nil},
+			{"A", TileKindBonus, 4, 1, nil},
+		},
+	}
+
+	tiles := NewTileSet(def)
+
+	assert.Equal(t, 88, len(tiles))
+	assert.False(t, ContainsTile(tiles, "W1"))
+	assert.Equal(t, 1, CountSpecificTile(tiles, "A4"))
+
+	assert.Nil(t, def.GetSuit(TileSuitWan))
+	assert.True(t, TaiwanRuleSet.IsBonusTile(def, "A1"))
+	assert.False(t, TaiwanRuleSet.IsBonusTile(def, "D1"))
+}
+
+func Test_TileSetDef_UnmarshalOldFormat(t *testing.T) {
+
+	data := `{
+		"wan": {"suit": "W", "numbers": 9, "count": 4, "excludes": [2, 3, 4, 5, 6, 7, 8]},
+		"tong": {"suit": "T", "numbers": 9, "count": 4},
+		"bamboo": {"suit": "B", "numbers": 9, "count": 4},
+		"wind": {"suit": "I", "numbers": 4, "count": 4},
+		"dragon": {"suit": "D", "numbers": 3, "count": 4},
+		"flower": {"suit": "F", "numbers": 4, "count": 1},
+		"season": {"suit": "S", "numbers": 4, "count": 1}
+	}`
+
+	var def TileSetDef
+	assert.Nil(t, json.Unmarshal([]byte(data), &def))
+	assert.Equal(t, ThreePlayerSetOfTiles, &def)
+
+	// New format goes through as it is
+	b, err := json.Marshal(StandardSetOfTiles)
+	assert.Nil(t, err)
+
+	def = TileSetDef{}
+	assert.Nil(t, json.Unmarshal(b, &def))
+	assert.Equal(t, StandardSetOfTiles, &def)
+}
@@ -0,0 +1,396 @@
+package foursquare
+
+import (
+	"sort"
+	"strconv"
+)
+
+// 百搭, suit of joker tiles which are usually marked as wild
+const TileSuitJoker TileSuit = "J"
+
+// WildDef marks tiles as wild (百搭), they stand for any tile which is not bonus tile
+type WildDef struct {
+	Tiles   []string `json:"tiles"`
+	InEyes  bool     `json:"in_eyes"`  // Wild tile can be one of eyes
+	InChow  bool     `json:"in_chow"`  // Wild tile can be a part of straight
+	InClaim bool     `json:"in_claim"` // Wild tile can make up pung or kong of discarded tile, never chow
+}
+
+// GetWild returns definition of wild tiles, it is nil if tile set has no wild tiles
+func (def *TileSetDef) GetWild() *WildDef {
+
+	if def == nil || def.Wild == nil || len(def.Wild.Tiles) == 0 {
+		return nil
+	}
+
+	return def.Wild
+}
+
+// IsWildTile checks if tile is wild in tile set
+func (def *TileSetDef) IsWildTile(tile string) bool {
+
+	wild := def.GetWild()
+	if wild == nil {
+		return false
+	}
+
+	return ContainsTile(wild.Tiles, tile)
+}
+
+// SplitWildTiles separates wild tiles from the others
+func SplitWildTiles(tileSetDef *TileSetDef, tiles []string) ([]string, []string) {
+
+	normal := make([]string, 0, len(tiles))
+	wilds := make([]string, 0)
+
+	for _, t := range tiles {
+		if tileSetDef.IsWildTile(t) {
+			wilds = append(wilds, t)
+			continue
+		}
+
+		normal = append(normal, t)
+	}
+
+	return normal, wilds
+}
+
+// CheckWinningTilesWithWilds checks if tiles make sets, and a pair of eyes if hasEyes, with help of wild tiles.
+// It returns eyes as well, eyes are wild tile if both of them are wild.
+func CheckWinningTilesWithWilds(tileSetDef *TileSetDef, tiles []string, wilds []string, hasEyes bool) (bool, string) {
+
+	wild := tileSetDef.GetWild()
+	if wild == nil {
+		wild = &WildDef{}
+	}
+
+	if (len(tiles)+len(wilds))%3 != 0 && !hasEyes {
+		return false, ""
+	}
+
+	r := newWildResolver(tileSetDef, wild, tiles)
+
+	wildTile := ""
+	if len(wilds) > 0 {
+		wildTile = wilds[0]
+	}
+
+	return r.solve(len(wilds), wildTile, hasEyes)
+}
+
+type wildResolver struct {
+	tileSetDef *TileSetDef
+	wild       *WildDef
+	tiles      []string // Kinds of tile in order of suit and number
+	counts     []int
+}
+
+func newWildResolver(tileSetDef *TileSetDef, wild *WildDef, tiles []string) *wildResolver {
+
+	r := &wildResolver{
+		tileSetDef: tileSetDef,
+		wild:       wild,
+		tiles:      AggregateTiles(tiles),
+	}
+
+	// Tiles of suit are in order of number, smaller one goes first
+	sort.Slice(r.tiles, func(i, j int) bool {
+
+		a, b := r.tiles[i], r.tiles[j]
+		if a[0:1] != b[0:1] {
+			return a[0:1] < b[0:1]
+		}
+
+		x, _ := strconv.Atoi(a[1:])
+		y, _ := strconv.Atoi(b[1:])
+
+		return x < y
+	})
+
+	counts := CountByTiles(tiles)
+	for _, t := range r.tiles {
+		r.counts = append(r.counts, counts[t])
+	}
+
+	return r
+}
+
+func (r *wildResolver) indexOf(tile string) int {
+
+	for i, t := range r.tiles {
+		if t == tile {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (r *wildResolver) solve(wilds int, wildTile string, needEyes bool) (bool, string) {
+
+	// The first tile which is left, every copy of it must be a part of a set or eyes
+	idx := -1
+	for i, c := range r.counts {
+		if c > 0 {
+			idx = i
+			break
+		}
+	}
+
+	// Wild tiles which are left make sets by themselves
+	if idx == -1 {
+
+		if !needEyes {
+			return wilds%3 == 0, ""
+		}
+
+		if r.wild.InEyes && wilds >= 2 && (wilds-2)%3 == 0 {
+			return true, wildTile
+		}
+
+		return false, ""
+	}
+
+	tile := r.tiles[idx]
+
+	// Pung
+	for n := 3; n >= 1; n-- {
+
+		if r.counts[idx] < n || wilds < 3-n {
+			continue
+		}
+
+		r.counts[idx] -= n
+		ok, eyes := r.solve(wilds-(3-n), wildTile, needEyes)
+		r.counts[idx] += n
+
+		if ok {
+			return true, eyes
+		}
+	}
+
+	// Straight
+	if ok, eyes := r.solveStraight(idx, wilds, wildTile, needEyes); ok {
+		return true, eyes
+	}
+
+	// Eyes
+	if needEyes {
+
+		if r.counts[idx] >= 2 {
+			r.counts[idx] -= 2
+			ok, _ := r.solve(wilds, wildTile, false)
+			r.counts[idx] += 2
+
+			if ok {
+				return true, tile
+			}
+		}
+
+		if r.wild.InEyes && wilds >= 1 {
+			r.counts[idx]--
+			ok, _ := r.solve(wilds-1, wildTile, false)
+			r.counts[idx]++
+
+			if ok {
+				return true, tile
+			}
+		}
+	}
+
+	return false, ""
+}
+
+func (r *wildResolver) solveStraight(idx int, wilds int, wildTile string, needEyes bool) (bool, string) {
+
+	tile := r.tiles[idx]
+	suit := TileSuit(tile[0:1])
+
+	if GetResolverRules(r.tileSetDef, suit) != SuitedTileRule {
+		return false, ""
+	}
+
+	num, err := strconv.Atoi(tile[1:])
+	if err != nil {
+		return false, ""
+	}
+
+	numbers := 9
+	if td := r.tileSetDef.GetSuit(suit); td != nil {
+		numbers = td.Numbers
+	}
+
+	// Tile is the first, second or the last one of straight, tiles before it must be wild
+	for start := num - 2; start <= num; start++ {
+
+		if start < 1 || start+2 > numbers {
+			continue
+		}
+
+		if start < num && !r.wild.InChow {
+			continue
+		}
+
+		ok, eyes := r.solveStraightFrom(suit, start, num, 0, wilds-(num-start), wildTile, needEyes)
+		if ok {
+			return true, eyes
+		}
+	}
+
+	return false, ""
+}
+
+// solveStraightFrom takes tiles of straight from position, missing tiles are made up with wild tiles
+func (r *wildResolver) solveStraightFrom(suit TileSuit, start int, num int, pos int, wilds int, wildTile string, needEyes bool) (bool, string) {
+
+	if wilds < 0 {
+		return false, ""
+	}
+
+	if pos == 3 {
+		return r.solve(wilds, wildTile, needEyes)
+	}
+
+	n := start + pos
+
+	// Tiles before the first tile are made up already
+	if n < num {
+		return r.solveStraightFrom(suit, start, num, pos+1, wilds, wildTile, needEyes)
+	}
+
+	idx := r.indexOf(string(suit) + strconv.Itoa(n))
+	if idx != -1 && r.counts[idx] > 0 {
+
+		r.counts[idx]--
+		ok, eyes := r.solveStraightFrom(suit, start, num, pos+1, wilds, wildTile, needEyes)
+		r.counts[idx]++
+
+		if ok {
+			return true, eyes
+		}
+	}
+
+	// The first tile must be a real one
+	if n == num || !r.wild.InChow {
+		return false, ""
+	}
+
+	return r.solveStraightFrom(suit, start, num, pos+1, wilds-1, wildTile, needEyes)
+}
+
+// resolveWithWilds resolves tiles of a tile set which has wild tiles
+func resolveWithWilds(tileSetDef *TileSetDef, tiles []string) *ResolvedState {
+
+	state := NewResolvedState()
+
+	normal, wilds := SplitWildTiles(tileSetDef, tiles)
+
+	switch len(tiles) % 3 {
+	case 0:
+		state.IsWin, _ = CheckWinningTilesWithWilds(tileSetDef, normal, wilds, false)
+	case 2:
+		isWin, eyes := CheckWinningTilesWithWilds(tileSetDef, normal, wilds, true)
+		if isWin {
+			state.IsWin = true
+			state.Eyes = append(state.Eyes, eyes)
+		}
+	case 1:
+		for _, t := range figurePlayableTiles(tileSetDef) {
+
+			n, w := normal, wilds
+			if tileSetDef.IsWildTile(t) {
+				w = append(append([]string{}, wilds...), t)
+			} else {
+				n = append(append([]string{}, normal...), t)
+			}
+
+			if ok, _ := CheckWinningTilesWithWilds(tileSetDef, n, w, true); ok {
+				state.ReadyHandCandidates = append(state.ReadyHandCandidates, t)
+			}
+		}
+
+		state.IsReadyHand = len(state.ReadyHandCandidates) > 0
+	}
+
+	return state
+}
+
+// figurePlayableTiles returns all kinds of tile which are able to be in hand
+func figurePlayableTiles(tileSetDef *TileSetDef) []string {
+
+	if tileSetDef == nil {
+		tileSetDef = StandardSetOfTiles
+	}
+
+	tiles := make([]string, 0)
+
+	for _, td := range tileSetDef.Suits {
+
+		if td.Kind == TileKindBonus {
+			continue
+		}
+
+		tiles = append(tiles, td.GenTiles(1)...)
+	}
+
+	// Wild tiles may be out of suits, for instance a flower which is wild
+	if wild := tileSetDef.GetWild(); wild != nil {
+		for _, t := range wild.Tiles {
+			if !ContainsTile(tiles, t) {
+				tiles = append(tiles, t)
+			}
+		}
+	}
+
+	return tiles
+}
+
+// takeTilesWithWilds removes count of tile from tiles, missing ones are made up with wild tiles if rule allows
+func takeTilesWithWilds(tileSetDef *TileSetDef, tiles []string, tile string, count int) ([]string, []string, bool) {
+
+	newTiles := make([]string, 0, len(tiles))
+	wilds := make([]string, 0)
+
+	canUseWild := false
+	if wild := tileSetDef.GetWild(); wild != nil && wild.InClaim && !tileSetDef.IsWildTile(tile) {
+		canUseWild = true
+	}
+
+	n := 0
+	for _, t := range tiles {
+
+		if n < count && t == tile {
+			n++
+			continue
+		}
+
+		newTiles = append(newTiles, t)
+	}
+
+	if n == count {
+		return newTiles, wilds, true
+	}
+
+	if !canUseWild {
+		return tiles, nil, false
+	}
+
+	// Make up missing tiles with wild tiles
+	rest := make([]string, 0, len(newTiles))
+	for _, t := range newTiles {
+
+		if n < count && tileSetDef.IsWildTile(t) {
+			wilds = append(wilds, t)
+			n++
+			continue
+		}
+
+		rest = append(rest, t)
+	}
+
+	if n < count {
+		return tiles, nil, false
+	}
+
+	return rest, wilds, true
+}
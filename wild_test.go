@@ -0,0 +1,205 @@
+package foursquare
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newJokerSetOfTiles(wild WildDef) *TileSetDef {
+
+	wild.Tiles = []string{"J1"}
+
+	return &TileSetDef{
+		Suits: append(append([]TileDef{}, StandardSetOfTiles.Suits...), TileDef{TileSuitJoker, TileKindHonor, 1, 8, nil}),
+		Wild:  &wild,
+	}
+}
+
+func Test_Wild_CheckWinningTilesWithWilds(t *testing.T) {
+
+	cases := []struct {
+		Wild  WildDef
+		IsWin bool
+		Eyes  string
+		Tiles []string
+	}{
+		// Pung with a joker
+		{WildDef{}, true, "W5", []string{"T1", "T1", "J1", "W5", "W5"}},
+		// Two jokers and a tile
+		{WildDef{}, true, "T5", []string{"W1", "J1", "J1", "T5", "T5"}},
+		// Straight with a joker
+		{WildDef{InChow: true}, true, "T5", []string{"W1", "J1", "W3", "T5", "T5"}},
+		{WildDef{}, false, "", []string{"W1", "J1", "W3", "T5", "T5"}},
+		// Joker stands for the tile before the first one
+		{WildDef{InChow: true}, true, "T5", []string{"J1", "W8", "W9", "T5", "T5"}},
+		// Joker in eyes
+		{WildDef{InEyes: true}, true, "T5", []string{"W1", "W2", "W3", "T5", "J1"}},
+		{WildDef{}, false, "", []string{"W1", "W2", "W3", "T5", "J1"}},
+		// Eyes of jokers
+		{WildDef{InEyes: true}, true, "J1", []string{"W1", "W2", "W3", "J1", "J1"}},
+		// Honor tiles never make straight
+		{WildDef{InChow: true}, false, "", []string{"I1", "J1", "I3", "T5", "T5"}},
+	}
+
+	for _, c := range cases {
+
+		def := newJokerSetOfTiles(c.Wild)
+		normal, wilds := SplitWildTiles(def, c.Tiles)
+
+		isWin, eyes := CheckWinningTilesWithWilds(def, normal, wilds, true)
+		assert.Equal(t, c.IsWin, isWin, c.Tiles)
+		assert.Equal(t, c.Eyes, eyes, c.Tiles)
+
+		// Resolve works the same way
+		assert.Equal(t, c.IsWin, Resolve(def, c.Tiles).IsWin, c.Tiles)
+	}
+}
+
+func Test_Wild_Resolve_ReadyHand(t *testing.T) {
+
+	def := newJokerSetOfTiles(WildDef{})
+
+	// Waiting for W2 or T5, joker completes it as well
+	state := Resolve(def, []string{"W2", "W2", "T5", "T5"})
+	assert.True(t, state.IsReadyHand)
+	assert.ElementsMatch(t, []string{"W2", "T5", "J1"}, state.ReadyHandCandidates)
+
+	// Joker can't be in straight
+	state = Resolve(def, []string{"W2", "W3", "T5", "T5"})
+	assert.ElementsMatch(t, []string{"W1", "W4"}, state.ReadyHandCandidates)
+
+	// Joker can't be eyes, so it only waits for T5
+	state = Resolve(def, []string{"W2", "W3", "W4", "T5"})
+	assert.True(t, state.IsReadyHand)
+	assert.ElementsMatch(t, []string{"T5"}, state.ReadyHandCandidates)
+
+	assert.ElementsMatch(t, []string{"T5"}, FigureWinningTiles(def, []string{"W2", "W3", "W4", "T5"}))
+}
+
+func Test_Wild_Claim(t *testing.T) {
+
+	def := newJokerSetOfTiles(WildDef{InClaim: true})
+
+	h := NewHand()
+	h.Tiles = []string{"W1", "J1", "J1", "T5"}
+
+	actions := h.FigureReactions(def, "W2", 2)
+
+	names := make([]string, 0)
+	for _, a := range actions {
+		names = append(names, a.Name)
+	}
+
+	assert.ElementsMatch(t, []string{"pung"}, names)
+
+	assert.Nil(t, h.DoPungWithWilds(def, "W2"))
+	assert.Equal(t, []string{"W2"}, h.Triplet)
+	assert.Equal(t, []string{"J1", "J1"}, h.Wilds)
+	assert.ElementsMatch(t, []string{"W1", "T5"}, h.Tiles)
+	assert.Equal(t, []string{"J1", "J1"}, FigureWildTiles(def, h))
+
+	// Real tiles are taken first
+	h = NewHand()
+	h.Tiles = []string{"W1", "W1", "J1", "T5"}
+
+	assert.Nil(t, h.DoOpenKongWithWilds(def, "W1"))
+	assert.Equal(t, []string{"W1"}, h.Kong.Open)
+	assert.Equal(t, []string{"J1"}, h.Wilds)
+	assert.Equal(t, []string{"T5"}, h.Tiles)
+
+	// Jokers can't be used to claim without the rule
+	def = newJokerSetOfTiles(WildDef{})
+
+	h = NewHand()
+	h.Tiles = []string{"W1", "J1", "J1", "T5"}
+
+	assert.Empty(t, h.FigureReactions(def, "W2", 2))
+	assert.Equal(t, ErrInvalidAction, h.DoPungWithWilds(def, "W2"))
+	assert.Equal(t, []string{"W1", "J1", "J1", "T5"}, h.Tiles)
+}
+
+func Test_Wild_NoWilds(t *testing.T) {
+
+	pc := NewPointCalculator(map[PointType]PointRule{
+		NoWilds: {Type: NoWilds, Point: 2},
+	})
+
+	assert.Equal(t, 2, pc.NoWilds(&WinContext{}))
+	assert.Equal(t, 0, pc.NoWilds(&WinContext{WildTiles: []string{"J1"}}))
+}
+
+func Test_Wild_Segmentations(t *testing.T) {
+
+	pc := NewPointCalculator(StandardRules)
+	pc.TileSetDef = newJokerSetOfTiles(WildDef{InEyes: true, InChow: true})
+
+	newHand := func(tiles []string) *Hand {
+		h := NewHand()
+		h.Tiles = tiles
+		return h
+	}
+
+	// Joker may stand for T1 or T4, so it is not certain that there are three concealed pungs
+	hand := newHand([]string{"W1", "W1", "W1", "W5", "W5", "W5", "B9", "B9", "B9", "T2", "T3", "J1", "D1", "D1"})
+	assert.Zero(t, pc.ThreeConcealedPungs(hand))
+
+	hand.Tiles[11] = "T4"
+	assert.NotZero(t, pc.ThreeConcealedPungs(hand))
+
+	hand = newHand([]string{"W1", "W2", "W3", "W4", "W5", "W6", "B7", "B8", "B9", "T2", "T3", "J1", "T5", "T5"})
+	assert.Zero(t, pc.MinimalPoints(hand))
+
+	hand.Tiles[11] = "T4"
+	assert.NotZero(t, pc.MinimalPoints(hand))
+}
+
+func Test_Wild_Game_Win(t *testing.T) {
+
+	def := newJokerSetOfTiles(WildDef{InEyes: true, InChow: true, InClaim: true})
+
+	opts := NewOptions()
+	opts.TileSetDef = def
+	opts.Dices = RollDices()
+	opts.Tiles = NewTileSet(def)
+
+	g := NewGame(opts)
+	assert.Nil(t, g.InitializeGame())
+
+	// Joker makes up straight of T7 and T8
+	banker := g.GetPlayer(0)
+	banker.Hand = NewHand()
+	banker.Hand.Tiles = []string{
+		"W1", "W1", "W1", "T2", "T2", "T2", "B3", "B3", "B3", "W5", "W6", "W7", "T7", "T8", "J1", "D1",
+	}
+
+	// Banker draws D1
+	g.gs.Meta.Tiles[g.gs.Status.CurrentTileSetPosition] = "D1"
+	assert.Nil(t, g.StartAtBanker())
+
+	assert.True(t, banker.IsAllowedAction("win"))
+	assert.Nil(t, g.Act("win"))
+	assert.Equal(t, GetGameEventSymbols(GameEvent_GameClosed), g.gs.Status.CurrentEvent)
+
+	result := g.gs.Result.Winners[0]
+	assert.NotZero(t, result.Points)
+	assert.Equal(t, []string{"J1"}, result.Context.WildTiles)
+	assert.True(t, result.Context.IsSelfDrawn)
+	assert.Zero(t, result.Conditions[ThreeConcealedPungs])
+	assert.Zero(t, result.Conditions[NoWilds])
+}
+
+func Test_Wild_Simulate(t *testing.T) {
+
+	opts := NewSimulatorOptions()
+	opts.Games = 10
+	opts.Seed = 3
+	opts.GameOptions.TileSetDef = newJokerSetOfTiles(WildDef{InEyes: true, InChow: true, InClaim: true})
+
+	stats, err := NewSimulator(opts).Run()
+	assert.Nil(t, err)
+	assert.Equal(t, 10, stats.Games)
+
+	// Money never comes from nowhere
+	assert.Zero(t, sumOf(stats.Scores))
+}
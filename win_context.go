@@ -0,0 +1,66 @@
+package foursquare
+
+// WinContext describes how a hand was won
+type WinContext struct {
+	Winner       int    `json:"winner"`
+	WinningTile  string `json:"winning_tile"`
+	SourcePlayer int    `json:"source_player"`  // Discarding player, or winner itself for self-drawn
+	IsSelfDrawn  bool   `json:"is_self_drawn"`  // 自摸
+	IsAfterKong  bool   `json:"is_after_kong"`  // Drawn from supplement after a kong, or discarded right after a kong
+	IsLastTile   bool   `json:"is_last_tile"`   // No more tiles in wall, it is either the last tile or the last discard
+	IsRobbedKong bool   `json:"is_robbed_kong"` // 搶槓
+	IsReadyHand  bool   `json:"is_ready_hand"`  // Winner declared ready hand
+	Turn         int    `json:"turn"`
+
+	IsEightImmortals bool `json:"is_eight_immortals"` // 八仙過海
+	IsSevenRobOne    bool `json:"is_seven_rob_one"`   // 七搶一
+
+	WildTiles []string `json:"wild_tiles,omitempty"` // Wild tiles (百搭) in winning hand, including melded ones
+}
+
+func (g *Game) newWinContext(winnerIdx int, p *GameEventPayload_Win) *WinContext {
+
+	wc := &WinContext{
+		Winner:       winnerIdx,
+		WinningTile:  p.WinningTile,
+		SourcePlayer: p.DiscardingPlayer,
+		IsSelfDrawn:  p.DiscardingPlayer == winnerIdx,
+		IsAfterKong:  g.gs.Status.AfterKong,
+		IsLastTile:   g.getRemainingTileCount() == 0,
+		IsRobbedKong: p.IsRobbedKong,
+		Turn:         g.gs.Status.Turn,
+
+		IsEightImmortals: p.IsEightImmortals,
+		IsSevenRobOne:    p.IsSevenRobOne,
+	}
+
+	// Winning with flowers has nothing to do with kong
+	if wc.IsFlowerWin() {
+		wc.IsAfterKong = false
+	}
+
+	ps := g.GetPlayer(winnerIdx)
+	if ps != nil {
+		wc.IsReadyHand = ps.IsReadyHand
+	}
+
+	return wc
+}
+
+// IsFlowerWin checks if hand was won with flowers instead of a complete hand
+func (wc *WinContext) IsFlowerWin() bool {
+	return wc.IsEightImmortals || wc.IsSevenRobOne
+}
+
+// FigureWildTiles returns wild tiles in concealed tiles and melds of hand
+func FigureWildTiles(tileSetDef *TileSetDef, hand *Hand) []string {
+
+	_, wilds := SplitWildTiles(tileSetDef, hand.Tiles)
+	wilds = append(wilds, hand.Wilds...)
+
+	if len(wilds) == 0 {
+		return nil
+	}
+
+	return wilds
+}